@@ -0,0 +1,62 @@
+package goenv
+
+import "math/big"
+
+// GetEnvBigInt returns the arbitrary-precision integer value of the environment variable
+// named by key, in base 10. If the variable is unset, empty, or cannot be parsed, it
+// returns a copy of fallback, or nil if fallback is nil, so the result never aliases
+// fallback.
+func GetEnvBigInt(key string, fallback *big.Int) *big.Int {
+	return GetEnvBigIntBase(key, 10, fallback)
+}
+
+// GetEnvBigIntBase is like GetEnvBigInt but parses the value in the given base. Base 0
+// detects the base from the prefix, so "0x", "0o" and "0b" values are accepted.
+func GetEnvBigIntBase(key string, base int, fallback *big.Int) *big.Int {
+	v, err := TryGetEnvBigIntBase(key, base)
+	if err != nil && fallback != nil {
+		fallback = new(big.Int).Set(fallback)
+	}
+	return orFallback(key, v, err, fallback)
+}
+
+// TryGetEnvBigInt returns the arbitrary-precision integer value of the environment
+// variable named by key, in base 10. It returns an error if the variable is unset,
+// empty, or cannot be parsed.
+func TryGetEnvBigInt(key string) (*big.Int, error) {
+	return TryGetEnvBigIntBase(key, 10)
+}
+
+// TryGetEnvBigIntBase returns the arbitrary-precision integer value of the environment
+// variable named by key, parsed with big.Int.SetString in the given base; base 0
+// detects the base from the prefix. It returns an error if the variable is unset,
+// empty, or cannot be parsed in base.
+func TryGetEnvBigIntBase(key string, base int) (*big.Int, error) {
+	v, err := tryGetEnv(key, "*big.Int")
+	if err != nil {
+		return nil, err
+	}
+	n, ok := new(big.Int).SetString(v, base)
+	if !ok {
+		return nil, parseErrorf(key, "unable to convert %q to a big integer in base %d", v, base)
+	}
+	return n, nil
+}
+
+// MustGetEnvBigInt returns the arbitrary-precision integer value of the environment
+// variable named by key, in base 10. It panics if the variable is unset, empty, or
+// cannot be parsed.
+func MustGetEnvBigInt(key string) *big.Int {
+	return MustGetEnvBigIntBase(key, 10)
+}
+
+// MustGetEnvBigIntBase returns the arbitrary-precision integer value of the environment
+// variable named by key, parsed in the given base. It panics if the variable is unset,
+// empty, or cannot be parsed in base.
+func MustGetEnvBigIntBase(key string, base int) *big.Int {
+	v, err := TryGetEnvBigIntBase(key, base)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
@@ -0,0 +1,81 @@
+package goenv_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/battlej07/goenv"
+)
+
+/* ---------- big.Int ---------- */
+
+func TestTryGetEnvBigIntBase(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		base    int
+		want    string
+		wantErr bool
+	}{
+		{name: "40 digits", value: "1234567890123456789012345678901234567890", base: 10, want: "1234567890123456789012345678901234567890"},
+		{name: "beyond uint128", value: "340282366920938463463374607431768211457", base: 10, want: "340282366920938463463374607431768211457"},
+		{name: "negative", value: "-99999999999999999999", base: 10, want: "-99999999999999999999"},
+		{name: "hex prefix with base 0", value: "0xFFFFFFFFFFFFFFFFFFFF", base: 0, want: "1208925819614629174706175"},
+		{name: "bare hex", value: "ff", base: 16, want: "255"},
+		{name: "hex prefix in base 10", value: "0x10", base: 10, wantErr: true},
+		{name: "garbage", value: "12ab", base: 10, wantErr: true},
+		{name: "empty", value: "", base: 10, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("ENV_BIGINT", tt.value)
+			got, err := goenv.TryGetEnvBigIntBase("ENV_BIGINT", tt.base)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("TryGetEnvBigIntBase() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && got.String() != tt.want {
+				t.Errorf("TryGetEnvBigIntBase() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetEnvBigInt(t *testing.T) {
+	fallback := big.NewInt(7)
+
+	t.Setenv("ENV_BIGINT", "340282366920938463463374607431768211457")
+	if got := goenv.GetEnvBigInt("ENV_BIGINT", fallback); got.String() != "340282366920938463463374607431768211457" {
+		t.Errorf("GetEnvBigInt() = %s", got)
+	}
+	if got, err := goenv.TryGetEnvBigInt("ENV_BIGINT"); err != nil || got.BitLen() != 129 {
+		t.Errorf("TryGetEnvBigInt() = %v, %v, want a 129-bit value", got, err)
+	}
+
+	t.Setenv("ENV_BIGINT", "garbage")
+	got := goenv.GetEnvBigInt("ENV_BIGINT", fallback)
+	if got.Cmp(fallback) != 0 {
+		t.Errorf("GetEnvBigInt() = %s, want fallback 7", got)
+	}
+	if got == fallback {
+		t.Error("GetEnvBigInt() returned the fallback pointer, want a copy")
+	}
+	got.SetInt64(1)
+	if fallback.Int64() != 7 {
+		t.Errorf("modifying the result changed the fallback to %s", fallback)
+	}
+	if got := goenv.GetEnvBigInt("ENV_BIGINT", nil); got != nil {
+		t.Errorf("GetEnvBigInt() = %s, want nil for a nil fallback", got)
+	}
+	if got := goenv.GetEnvBigIntBase("ENV_BIGINT", 36, fallback); got.Cmp(fallback) == 0 {
+		t.Errorf("GetEnvBigIntBase() = %s, want the base-36 value", got)
+	}
+
+	func() {
+		defer expectPanic(t, true)()
+		goenv.MustGetEnvBigInt("ENV_BIGINT")
+	}()
+	func() {
+		defer expectPanic(t, false)()
+		goenv.MustGetEnvBigIntBase("ENV_BIGINT", 36)
+	}()
+}
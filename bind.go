@@ -0,0 +1,79 @@
+package goenv
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"reflect"
+	"time"
+)
+
+// Bind populates the fields of the struct that target points to from environment
+// variables, using the field's Go type to pick the parser. It supports the types Load
+// supports, including []string as a comma-separated list.
+//
+// Fields are configured with struct tags:
+//   - `env:"KEY"` names the environment variable; fields without it are skipped.
+//   - `default:"value"` is used when the variable is unset, empty, or invalid, as with
+//     Load's fallback tag.
+//   - `required:"true"` makes an unset or empty variable an error, even with a default.
+//
+// Nested struct fields without an env tag are bound recursively; unexported fields are
+// skipped. A missing optional variable without a default leaves the field unchanged.
+// Bind reports every missing required key in a single error, joined with any parse
+// errors.
+func Bind(target any) error {
+	val := reflect.ValueOf(target)
+	if val.Kind() != reflect.Pointer || val.IsNil() {
+		return fmt.Errorf("Bind expects a non-nil pointer to a struct")
+	}
+
+	val = val.Elem()
+	if val.Kind() != reflect.Struct {
+		return fmt.Errorf("Bind expects a pointer to a struct, got %s", val.Kind())
+	}
+
+	var missing []string
+	var errs []error
+	bindStruct(val, &missing, &errs)
+	if len(missing) > 0 {
+		errs = append([]error{missingRequiredError(missing)}, errs...)
+	}
+	return errors.Join(errs...)
+}
+
+// bindStruct binds the fields of val, recording missing required keys and field errors.
+func bindStruct(val reflect.Value, missing *[]string, errs *[]error) {
+	typ := val.Type()
+	for i := 0; i < val.NumField(); i++ {
+		field := val.Field(i)
+		fieldType := typ.Field(i)
+
+		if !field.CanSet() {
+			continue
+		}
+
+		key := fieldType.Tag.Get("env")
+		if key == "" {
+			if field.Kind() == reflect.Struct && field.Type() != reflect.TypeFor[time.Time]() {
+				bindStruct(field, missing, errs)
+			}
+			continue
+		}
+
+		def := fieldType.Tag.Get("default")
+		required := fieldType.Tag.Get("required") == "true"
+		if os.Getenv(key) == "" && (required || def == "") {
+			// setField is skipped, so record the read here.
+			lookupEnv(key, field.Type().String())
+			if required {
+				*missing = append(*missing, key)
+			}
+			continue
+		}
+
+		if err := setField(field, key, def); err != nil {
+			*errs = append(*errs, fmt.Errorf("field %s: %w", fieldType.Name, err))
+		}
+	}
+}
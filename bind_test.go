@@ -0,0 +1,113 @@
+package goenv_test
+
+import (
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/battlej07/goenv"
+)
+
+/* ---------- Bind (struct tags) ---------- */
+
+type bindDB struct {
+	URL   string        `env:"BIND_DB_URL" required:"true"`
+	Pool  int           `env:"BIND_DB_POOL" default:"4"`
+	Retry time.Duration `env:"BIND_DB_RETRY" default:"2s"`
+}
+
+type bindConfig struct {
+	Host     string    `env:"BIND_HOST" default:"localhost"`
+	Port     int       `env:"BIND_PORT" required:"true"`
+	Debug    bool      `env:"BIND_DEBUG"`
+	Ratio    float64   `env:"BIND_RATIO" default:"0.5"`
+	Start    time.Time `env:"BIND_START"`
+	Origins  []string  `env:"BIND_ORIGINS" default:"a.com,b.com"`
+	DB       bindDB
+	Untagged string
+	secret   string `env:"BIND_SECRET"`
+}
+
+func TestBind(t *testing.T) {
+	t.Setenv("BIND_PORT", "8080")
+	t.Setenv("BIND_DEBUG", "true")
+	t.Setenv("BIND_START", "2024-01-02T03:04:05Z")
+	t.Setenv("BIND_DB_URL", "postgres://db")
+	t.Setenv("BIND_DB_POOL", "10")
+	t.Setenv("BIND_SECRET", "ignored")
+
+	var cfg bindConfig
+	if err := goenv.Bind(&cfg); err != nil {
+		t.Fatalf("Bind() failed: %v", err)
+	}
+
+	if cfg.Host != "localhost" || cfg.Port != 8080 || !cfg.Debug || cfg.Ratio != 0.5 {
+		t.Errorf("Bind() scalars = %+v", cfg)
+	}
+	if want := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC); !cfg.Start.Equal(want) {
+		t.Errorf("Start = %v, want %v", cfg.Start, want)
+	}
+	if want := []string{"a.com", "b.com"}; !slices.Equal(cfg.Origins, want) {
+		t.Errorf("Origins = %q, want %q", cfg.Origins, want)
+	}
+	if cfg.DB.URL != "postgres://db" || cfg.DB.Pool != 10 || cfg.DB.Retry != 2*time.Second {
+		t.Errorf("DB = %+v", cfg.DB)
+	}
+	if cfg.secret != "" {
+		t.Errorf("unexported field set to %q", cfg.secret)
+	}
+}
+
+func TestBindSliceFromEnv(t *testing.T) {
+	t.Setenv("BIND_PORT", "1")
+	t.Setenv("BIND_DB_URL", "x")
+	t.Setenv("BIND_ORIGINS", " c.com , d.com ,")
+
+	var cfg bindConfig
+	if err := goenv.Bind(&cfg); err != nil {
+		t.Fatalf("Bind() failed: %v", err)
+	}
+	if want := []string{"c.com", "d.com"}; !slices.Equal(cfg.Origins, want) {
+		t.Errorf("Origins = %q, want %q", cfg.Origins, want)
+	}
+}
+
+func TestBindMissingRequired(t *testing.T) {
+	t.Setenv("BIND_RATIO", "not-a-float")
+	t.Setenv("BIND_DEBUG", "maybe")
+
+	var cfg bindConfig
+	err := goenv.Bind(&cfg)
+	if err == nil {
+		t.Fatal("Bind() succeeded unexpectedly")
+	}
+	msg := err.Error()
+	if !strings.Contains(msg, "missing required env variables: BIND_PORT, BIND_DB_URL") {
+		t.Errorf("Bind() error does not list all missing keys: %v", msg)
+	}
+	if !strings.Contains(msg, "field Debug") {
+		t.Errorf("Bind() error does not report the parse failure: %v", msg)
+	}
+	if strings.Contains(msg, "field Ratio") {
+		t.Errorf("Bind() error reports Ratio despite its default: %v", msg)
+	}
+	if cfg.Ratio != 0.5 || cfg.DB.Pool != 4 {
+		t.Errorf("Ratio, DB.Pool = %v, %d, want defaults 0.5, 4 despite other errors", cfg.Ratio, cfg.DB.Pool)
+	}
+}
+
+func TestBindInvalidTarget(t *testing.T) {
+	var cfg bindConfig
+	for name, target := range map[string]any{
+		"nil":        nil,
+		"non-ptr":    cfg,
+		"ptr to int": new(int),
+	} {
+		t.Run(name, func(t *testing.T) {
+			if err := goenv.Bind(target); err == nil {
+				t.Error("Bind() succeeded unexpectedly")
+			}
+		})
+	}
+}
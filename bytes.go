@@ -0,0 +1,79 @@
+package goenv
+
+import (
+	"math"
+	"strconv"
+	"strings"
+)
+
+// byteUnits maps lower-cased size units to their multipliers. SI units are powers
+// of 1000 and IEC units are powers of 1024.
+var byteUnits = map[string]int64{
+	"":    1,
+	"b":   1,
+	"kb":  1e3,
+	"mb":  1e6,
+	"gb":  1e9,
+	"tb":  1e12,
+	"pb":  1e15,
+	"eb":  1e18,
+	"kib": 1 << 10,
+	"mib": 1 << 20,
+	"gib": 1 << 30,
+	"tib": 1 << 40,
+	"pib": 1 << 50,
+	"eib": 1 << 60,
+}
+
+// GetEnvBytes returns the byte size of the environment variable named by key, e.g.
+// "25MB" or "1GiB". If the variable is unset, empty, or not a valid size, it returns
+// fallback.
+func GetEnvBytes(key string, fallback int64) int64 {
+	v, err := TryGetEnvBytes(key)
+	return orFallback(key, v, err, fallback)
+}
+
+// TryGetEnvBytes returns the byte size of the environment variable named by key. The
+// value is a non-negative integer optionally followed by whitespace and a unit: B,
+// the SI units KB to EB (powers of 1000) or the IEC units KiB to EiB (powers of 1024).
+// Units are case-insensitive and a bare number is a count of bytes, so "1024", "1KiB"
+// and "1 kib" are all 1024. It returns an error if the variable is unset, empty, is
+// fractional such as "1.5GB", has an unknown unit, or overflows int64.
+func TryGetEnvBytes(key string) (int64, error) {
+	v, err := tryGetEnv(key, "int64")
+	if err != nil {
+		return 0, err
+	}
+
+	s := strings.TrimSpace(v)
+	i := strings.IndexFunc(s, func(r rune) bool { return r < '0' || r > '9' })
+	if i < 0 {
+		i = len(s)
+	}
+	num, unit := s[:i], strings.ToLower(strings.TrimSpace(s[i:]))
+	if num == "" {
+		return 0, parseErrorf(key, "unable to parse %q as a byte size: missing number", v)
+	}
+	if strings.HasPrefix(s[i:], ".") {
+		return 0, parseErrorf(key, "unable to parse %q as a byte size: fractional sizes are not supported", v)
+	}
+	mult, ok := byteUnits[unit]
+	if !ok {
+		return 0, parseErrorf(key, "unable to parse %q as a byte size: unknown unit %q", v, s[i:])
+	}
+	n, err := strconv.ParseInt(num, 10, 64)
+	if err != nil || n > math.MaxInt64/mult {
+		return 0, parseErrorf(key, "unable to parse %q as a byte size: overflows int64", v)
+	}
+	return n * mult, nil
+}
+
+// MustGetEnvBytes returns the byte size of the environment variable named by key.
+// It panics if the variable is unset, empty, or not a valid size.
+func MustGetEnvBytes(key string) int64 {
+	v, err := TryGetEnvBytes(key)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
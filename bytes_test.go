@@ -0,0 +1,92 @@
+package goenv_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/battlej07/goenv"
+)
+
+/* ---------- byte sizes ---------- */
+
+func TestTryGetEnvBytes(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		want    int64
+		wantErr bool
+	}{
+		{name: "bare number", value: "1024", want: 1024},
+		{name: "bytes unit", value: "512B", want: 512},
+		{name: "IEC", value: "1KiB", want: 1024},
+		{name: "lower-case SI with space", value: "2 mb", want: 2_000_000},
+		{name: "upper-case IEC", value: "1GIB", want: 1 << 30},
+		{name: "surrounding whitespace", value: "  25MB ", want: 25_000_000},
+		{name: "zero", value: "0", want: 0},
+		{name: "unknown unit", value: "5XB", wantErr: true},
+		{name: "missing number", value: "MB", wantErr: true},
+		{name: "fractional", value: "1.5GB", wantErr: true},
+		{name: "negative", value: "-1KB", wantErr: true},
+		{name: "overflow", value: "9EiB", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("ENV_BYTES", tt.value)
+			got, err := goenv.TryGetEnvBytes("ENV_BYTES")
+			if err != nil {
+				if !tt.wantErr {
+					t.Errorf("TryGetEnvBytes() failed: %v", err)
+				} else if !errors.Is(err, goenv.ErrParse) {
+					t.Errorf("TryGetEnvBytes() error = %v, want ErrParse", err)
+				}
+				return
+			}
+			if tt.wantErr {
+				t.Fatalf("TryGetEnvBytes() = %d, want error", got)
+			}
+			if got != tt.want {
+				t.Errorf("TryGetEnvBytes() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTryGetEnvBytesFractional(t *testing.T) {
+	t.Setenv("ENV_BYTES", "1.5GB")
+	_, err := goenv.TryGetEnvBytes("ENV_BYTES")
+	if err == nil || !strings.Contains(err.Error(), `"1.5GB"`) || !strings.Contains(err.Error(), "fractional sizes are not supported") {
+		t.Errorf("TryGetEnvBytes() error = %v, want a fractional size error naming \"1.5GB\"", err)
+	}
+}
+
+func TestGetEnvBytes(t *testing.T) {
+	t.Setenv("ENV_BYTES", "4KB")
+	if got := goenv.GetEnvBytes("ENV_BYTES", 1); got != 4000 {
+		t.Errorf("GetEnvBytes() = %d, want 4000", got)
+	}
+	t.Setenv("ENV_BYTES", "5XB")
+	if got := goenv.GetEnvBytes("ENV_BYTES", 1); got != 1 {
+		t.Errorf("GetEnvBytes() = %d, want fallback 1", got)
+	}
+	t.Setenv("ENV_BYTES", "")
+	if got := goenv.GetEnvBytes("ENV_BYTES", 1); got != 1 {
+		t.Errorf("GetEnvBytes() = %d, want fallback 1", got)
+	}
+}
+
+func TestMustGetEnvBytes(t *testing.T) {
+	t.Setenv("ENV_BYTES", "5XB")
+	func() {
+		defer expectPanic(t, true)()
+		goenv.MustGetEnvBytes("ENV_BYTES")
+	}()
+
+	t.Setenv("ENV_BYTES", "1MiB")
+	func() {
+		defer expectPanic(t, false)()
+		if got := goenv.MustGetEnvBytes("ENV_BYTES"); got != 1<<20 {
+			t.Errorf("MustGetEnvBytes() = %d, want %d", got, 1<<20)
+		}
+	}()
+}
@@ -0,0 +1,149 @@
+package goenv
+
+import (
+	"errors"
+	"slices"
+	"sync"
+	"time"
+)
+
+// Cache memoizes parsed environment variables so hot paths do not re-parse the same
+// value on every call. Its methods mirror the package-level functions of the same name,
+// but each key and type is looked up and parsed once, and later calls return the cached
+// result, including a cached error. A cached value is returned even if the variable
+// later changes, until Invalidate or Clear is called. Fallbacks are not cached, so each
+// call may pass its own. The zero value is ready to use, and a Cache is safe for
+// concurrent use.
+type Cache struct {
+	mu      sync.RWMutex
+	entries map[cacheKey]cacheEntry
+}
+
+// cacheKey identifies a cached result by variable name and requested type.
+type cacheKey struct {
+	key string
+	typ string
+}
+
+// cacheEntry is the outcome of the Try function run for a cacheKey.
+type cacheEntry struct {
+	v   any
+	err error
+}
+
+// NewCache returns an empty Cache.
+func NewCache() *Cache {
+	return &Cache{}
+}
+
+// Invalidate drops every cached result for key, so the next call re-reads it.
+func (c *Cache) Invalidate(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for k := range c.entries {
+		if k.key == key {
+			delete(c.entries, k)
+		}
+	}
+}
+
+// Clear drops all cached results.
+func (c *Cache) Clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries = nil
+}
+
+// GetEnv is GetEnv with the result cached.
+func (c *Cache) GetEnv(key string, fallback string) string {
+	v, err := c.TryGetEnv(key)
+	return orFallback(key, v, err, fallback)
+}
+
+// TryGetEnv is TryGetEnv with the result cached.
+func (c *Cache) TryGetEnv(key string) (string, error) {
+	return cached(c, key, "string", TryGetEnv)
+}
+
+// GetEnvInt is GetEnvInt with the parsed result cached.
+func (c *Cache) GetEnvInt(key string, fallback int) int {
+	v, err := c.TryGetEnvInt(key)
+	return orFallback(key, v, err, fallback)
+}
+
+// TryGetEnvInt is TryGetEnvInt with the parsed result cached.
+func (c *Cache) TryGetEnvInt(key string) (int, error) {
+	return cached(c, key, "int", TryGetEnvInt)
+}
+
+// GetEnvFloat64 is GetEnvFloat64 with the parsed result cached.
+func (c *Cache) GetEnvFloat64(key string, fallback float64) float64 {
+	v, err := c.TryGetEnvFloat64(key)
+	return orFallback(key, v, err, fallback)
+}
+
+// TryGetEnvFloat64 is TryGetEnvFloat64 with the parsed result cached.
+func (c *Cache) TryGetEnvFloat64(key string) (float64, error) {
+	return cached(c, key, "float64", TryGetEnvFloat64)
+}
+
+// GetEnvBool is GetEnvBool with the parsed result cached.
+func (c *Cache) GetEnvBool(key string, fallback bool) bool {
+	v, err := c.TryGetEnvBool(key)
+	return orFallback(key, v, err, fallback)
+}
+
+// TryGetEnvBool is TryGetEnvBool with the parsed result cached.
+func (c *Cache) TryGetEnvBool(key string) (bool, error) {
+	return cached(c, key, "bool", TryGetEnvBool)
+}
+
+// GetEnvDuration is GetEnvDuration with the parsed result cached.
+func (c *Cache) GetEnvDuration(key string, fallback time.Duration) time.Duration {
+	v, err := c.TryGetEnvDuration(key)
+	return orFallback(key, v, err, fallback)
+}
+
+// TryGetEnvDuration is TryGetEnvDuration with the parsed result cached.
+func (c *Cache) TryGetEnvDuration(key string) (time.Duration, error) {
+	return cached(c, key, "time.Duration", TryGetEnvDuration)
+}
+
+// GetEnvStringSlice is GetEnvStringSlice with the parsed result cached. Each call
+// returns a fresh copy, so callers may modify it.
+func (c *Cache) GetEnvStringSlice(key string, fallback []string) []string {
+	v, err := c.TryGetEnvStringSlice(key)
+	return orFallback(key, v, err, fallback)
+}
+
+// TryGetEnvStringSlice is TryGetEnvStringSlice with the parsed result cached. Each
+// call returns a fresh copy, so callers may modify it.
+func (c *Cache) TryGetEnvStringSlice(key string) ([]string, error) {
+	v, err := cached(c, key, "[]string", TryGetEnvStringSlice)
+	return slices.Clone(v), err
+}
+
+// cached returns the result of try for key from c, running and storing it on first use.
+// try records its own read; a result served from c is recorded as a read of typ.
+func cached[T any](c *Cache, key, typ string, try func(string) (T, error)) (T, error) {
+	k := cacheKey{key: key, typ: typ}
+	c.mu.RLock()
+	e, ok := c.entries[k]
+	c.mu.RUnlock()
+	if !ok {
+		c.mu.Lock()
+		if e, ok = c.entries[k]; !ok {
+			v, err := try(key)
+			e = cacheEntry{v: v, err: err}
+			if c.entries == nil {
+				c.entries = make(map[cacheKey]cacheEntry)
+			}
+			c.entries[k] = e
+		}
+		c.mu.Unlock()
+	}
+	if ok && trackingEnabled.Load() {
+		appendAccess(Access{Key: key, Type: typ, Found: !errors.Is(e.err, ErrNotFound)})
+	}
+	return e.v.(T), e.err
+}
@@ -0,0 +1,111 @@
+package goenv_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/battlej07/goenv"
+)
+
+/* ---------- cache ---------- */
+
+func TestCache(t *testing.T) {
+	c := goenv.NewCache()
+	t.Setenv("CACHE_TIMEOUT", "5s")
+	t.Setenv("CACHE_PORT", "8080")
+
+	if got := c.GetEnvDuration("CACHE_TIMEOUT", time.Second); got != 5*time.Second {
+		t.Fatalf("GetEnvDuration() = %v, want 5s", got)
+	}
+	if got := c.GetEnvInt("CACHE_PORT", 1); got != 8080 {
+		t.Fatalf("GetEnvInt() = %d, want 8080", got)
+	}
+
+	// The sentinel change is not seen until the key is invalidated.
+	t.Setenv("CACHE_TIMEOUT", "9s")
+	t.Setenv("CACHE_PORT", "9090")
+	if got := c.GetEnvDuration("CACHE_TIMEOUT", time.Second); got != 5*time.Second {
+		t.Errorf("GetEnvDuration() = %v, want cached 5s", got)
+	}
+	if got, err := c.TryGetEnvInt("CACHE_PORT"); err != nil || got != 8080 {
+		t.Errorf("TryGetEnvInt() = %d, %v, want cached 8080", got, err)
+	}
+
+	c.Invalidate("CACHE_TIMEOUT")
+	if got := c.GetEnvDuration("CACHE_TIMEOUT", time.Second); got != 9*time.Second {
+		t.Errorf("GetEnvDuration() after Invalidate = %v, want 9s", got)
+	}
+	if got := c.GetEnvInt("CACHE_PORT", 1); got != 8080 {
+		t.Errorf("GetEnvInt() = %d, want 8080 still cached after invalidating another key", got)
+	}
+
+	c.Clear()
+	if got := c.GetEnvInt("CACHE_PORT", 1); got != 9090 {
+		t.Errorf("GetEnvInt() after Clear = %d, want 9090", got)
+	}
+}
+
+func TestCacheTypesAndErrors(t *testing.T) {
+	var c goenv.Cache
+	t.Setenv("CACHE_VALUE", "1")
+
+	// The same key is cached separately per type.
+	if got := c.GetEnv("CACHE_VALUE", "x"); got != "1" {
+		t.Errorf("GetEnv() = %q, want 1", got)
+	}
+	if got := c.GetEnvBool("CACHE_VALUE", false); !got {
+		t.Error("GetEnvBool() = false, want true")
+	}
+	if got := c.GetEnvFloat64("CACHE_VALUE", 0); got != 1 {
+		t.Errorf("GetEnvFloat64() = %v, want 1", got)
+	}
+
+	// Errors are cached too, and fallbacks are applied per call.
+	t.Setenv("CACHE_BAD", "nope")
+	if got := c.GetEnvInt("CACHE_BAD", 1); got != 1 {
+		t.Errorf("GetEnvInt() = %d, want fallback 1", got)
+	}
+	t.Setenv("CACHE_BAD", "2")
+	if got := c.GetEnvInt("CACHE_BAD", 3); got != 3 {
+		t.Errorf("GetEnvInt() = %d, want fallback 3 from the cached error", got)
+	}
+	if _, err := c.TryGetEnvInt("CACHE_BAD"); err == nil {
+		t.Error("TryGetEnvInt() = nil error, want the cached parse error")
+	}
+	c.Invalidate("CACHE_BAD")
+	if got := c.GetEnvInt("CACHE_BAD", 3); got != 2 {
+		t.Errorf("GetEnvInt() after Invalidate = %d, want 2", got)
+	}
+
+	// Cached slices are copied on every call.
+	t.Setenv("CACHE_LIST", "a,b")
+	got := c.GetEnvStringSlice("CACHE_LIST", nil)
+	got[0] = "changed"
+	if again, _ := c.TryGetEnvStringSlice("CACHE_LIST"); again[0] != "a" {
+		t.Errorf("TryGetEnvStringSlice() = %q, want the cached copy unchanged", again)
+	}
+}
+
+func TestCacheConcurrent(t *testing.T) {
+	c := goenv.NewCache()
+	t.Setenv("CACHE_PORT", "8080")
+
+	var wg sync.WaitGroup
+	for i := range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range 100 {
+				if got := c.GetEnvInt("CACHE_PORT", 1); got != 8080 {
+					t.Errorf("GetEnvInt() = %d, want 8080", got)
+					return
+				}
+				if i == 0 {
+					c.Invalidate("CACHE_PORT")
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
@@ -0,0 +1,68 @@
+package goenv
+
+import "strconv"
+
+// GetEnvComplex128 returns the complex128 value of the environment variable named by
+// key, e.g. "1+2i", "3" or "4i". If the variable is unset, empty, or cannot be parsed,
+// it returns fallback.
+func GetEnvComplex128(key string, fallback complex128) complex128 {
+	v, err := TryGetEnvComplex128(key)
+	return orFallback(key, v, err, fallback)
+}
+
+// GetEnvComplex64 returns the complex64 value of the environment variable named by key.
+// If the variable is unset, empty, cannot be parsed, or overflows complex64, it returns
+// fallback.
+func GetEnvComplex64(key string, fallback complex64) complex64 {
+	v, err := TryGetEnvComplex64(key)
+	return orFallback(key, v, err, fallback)
+}
+
+// TryGetEnvComplex128 returns the complex128 value of the environment variable named by
+// key, parsed with strconv.ParseComplex. It returns an error if the variable is unset,
+// empty, or cannot be parsed, e.g. "1+i2".
+func TryGetEnvComplex128(key string) (complex128, error) {
+	return tryGetEnvComplex(key, 128)
+}
+
+// TryGetEnvComplex64 returns the complex64 value of the environment variable named by
+// key. It returns an error if the variable is unset, empty, cannot be parsed, or
+// overflows complex64.
+func TryGetEnvComplex64(key string) (complex64, error) {
+	c, err := tryGetEnvComplex(key, 64)
+	return complex64(c), err
+}
+
+// MustGetEnvComplex128 returns the complex128 value of the environment variable named
+// by key. It panics if the variable is unset, empty, or cannot be parsed.
+func MustGetEnvComplex128(key string) complex128 {
+	v, err := TryGetEnvComplex128(key)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// MustGetEnvComplex64 returns the complex64 value of the environment variable named by
+// key. It panics if the variable is unset, empty, cannot be parsed, or overflows
+// complex64.
+func MustGetEnvComplex64(key string) complex64 {
+	v, err := TryGetEnvComplex64(key)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// tryGetEnvComplex parses the value of key as a complex number of the given bit size.
+func tryGetEnvComplex(key string, bits int) (complex128, error) {
+	v, err := tryGetEnv(key, "complex"+strconv.Itoa(bits))
+	if err != nil {
+		return 0, err
+	}
+	c, err := strconv.ParseComplex(v, bits)
+	if err != nil {
+		return 0, parseErrorf(key, "unable to convert %q to complex%d: %w", v, bits, err)
+	}
+	return c, nil
+}
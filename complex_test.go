@@ -0,0 +1,81 @@
+package goenv_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/battlej07/goenv"
+)
+
+/* ---------- complex ---------- */
+
+func TestTryGetEnvComplex128(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		want    complex128
+		wantErr bool
+	}{
+		{name: "real and imaginary", value: "1+2i", want: complex(1, 2)},
+		{name: "negative imaginary", value: "-1.5-0.5i", want: complex(-1.5, -0.5)},
+		{name: "purely real", value: "3", want: complex(3, 0)},
+		{name: "purely imaginary", value: "4i", want: complex(0, 4)},
+		{name: "parenthesized", value: "(1+2i)", want: complex(1, 2)},
+		{name: "imaginary unit first", value: "1+i2", wantErr: true},
+		{name: "invalid", value: "gain", wantErr: true},
+		{name: "empty", value: "", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("ENV_COMPLEX", tt.value)
+			got, err := goenv.TryGetEnvComplex128("ENV_COMPLEX")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("TryGetEnvComplex128() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && got != tt.want {
+				t.Errorf("TryGetEnvComplex128() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTryGetEnvComplex64(t *testing.T) {
+	t.Setenv("ENV_COMPLEX", "1+2i")
+	if got, err := goenv.TryGetEnvComplex64("ENV_COMPLEX"); err != nil || got != complex64(complex(1, 2)) {
+		t.Errorf("TryGetEnvComplex64() = %v, %v, want (1+2i)", got, err)
+	}
+
+	t.Setenv("ENV_COMPLEX", "1e39+0i")
+	if _, err := goenv.TryGetEnvComplex64("ENV_COMPLEX"); !errors.Is(err, goenv.ErrParse) {
+		t.Errorf("TryGetEnvComplex64() error = %v, want an overflow parse error", err)
+	}
+	if _, err := goenv.TryGetEnvComplex128("ENV_COMPLEX"); err != nil {
+		t.Errorf("TryGetEnvComplex128() failed: %v", err)
+	}
+}
+
+func TestGetEnvComplex(t *testing.T) {
+	t.Setenv("ENV_COMPLEX", "2i")
+	if got := goenv.GetEnvComplex128("ENV_COMPLEX", 1); got != complex(0, 2) {
+		t.Errorf("GetEnvComplex128() = %v, want (0+2i)", got)
+	}
+	if got := goenv.GetEnvComplex64("ENV_COMPLEX", 1); got != complex64(complex(0, 2)) {
+		t.Errorf("GetEnvComplex64() = %v, want (0+2i)", got)
+	}
+
+	t.Setenv("ENV_COMPLEX", "1+i2")
+	if got := goenv.GetEnvComplex128("ENV_COMPLEX", 1); got != 1 {
+		t.Errorf("GetEnvComplex128() = %v, want fallback (1+0i)", got)
+	}
+	if got := goenv.GetEnvComplex64("ENV_COMPLEX", 1); got != 1 {
+		t.Errorf("GetEnvComplex64() = %v, want fallback (1+0i)", got)
+	}
+	func() {
+		defer expectPanic(t, true)()
+		goenv.MustGetEnvComplex128("ENV_COMPLEX")
+	}()
+	func() {
+		defer expectPanic(t, true)()
+		goenv.MustGetEnvComplex64("ENV_COMPLEX")
+	}()
+}
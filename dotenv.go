@@ -0,0 +1,171 @@
+package goenv
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// defaultDotenvFile is the file LoadFile reads when called without paths.
+const defaultDotenvFile = ".env"
+
+// dotenvValues holds the values read from dotenv files by LoadFile and OverloadFile,
+// including those not applied because the variable was already set, for
+// GetEnvStringSliceMerged.
+var (
+	dotenvMu     sync.Mutex
+	dotenvValues map[string]string
+)
+
+// LoadFile reads dotenv-style files and sets each variable with os.Setenv unless it
+// is already present in the environment, so real environment variables always win.
+// The name differs from Load, which populates a struct.
+//
+// Each line is KEY=VALUE; blank lines and lines starting with '#' are ignored, an
+// optional "export " prefix is allowed, and a value wrapped in matching single or
+// double quotes has the quotes stripped. When a key repeats within a file the last
+// value is used; across files the first file that sets a key wins.
+//
+// With no paths, LoadFile reads .env in the working directory and a missing .env is
+// not an error. A listed file that does not exist, or a malformed line, is an error.
+func LoadFile(paths ...string) error {
+	return loadDotenvFiles(paths, false)
+}
+
+// OverloadFile is like LoadFile but sets every variable unconditionally, overwriting
+// values already in the environment, e.g. for test fixtures. When a key is set in
+// several files the last file wins.
+func OverloadFile(paths ...string) error {
+	return loadDotenvFiles(paths, true)
+}
+
+// loadDotenvFiles implements LoadFile and OverloadFile.
+func loadDotenvFiles(paths []string, override bool) error {
+	optional := len(paths) == 0
+	if optional {
+		paths = []string{defaultDotenvFile}
+	}
+
+	for _, path := range paths {
+		vars, err := readDotenvFile(path)
+		if err != nil {
+			if optional && errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+			return err
+		}
+		recordDotenvValues(vars, override)
+		for k, v := range vars {
+			if _, ok := os.LookupEnv(k); ok && !override {
+				continue
+			}
+			if err := os.Setenv(k, v); err != nil {
+				return fmt.Errorf("%s: setting %s: %w", path, k, err)
+			}
+		}
+	}
+	return nil
+}
+
+// recordDotenvValues stores vars in dotenvValues with the precedence of the loader:
+// a key already recorded keeps its value unless override is set.
+func recordDotenvValues(vars map[string]string, override bool) {
+	dotenvMu.Lock()
+	defer dotenvMu.Unlock()
+	if dotenvValues == nil {
+		dotenvValues = make(map[string]string)
+	}
+	for k, v := range vars {
+		if _, ok := dotenvValues[k]; ok && !override {
+			continue
+		}
+		dotenvValues[k] = v
+	}
+}
+
+// GetEnvStringSliceMerged returns the union of the comma-separated elements of the
+// environment variable named by key and of the value that LoadFile or OverloadFile
+// read for key from a dotenv file, even if the file value was not applied because the
+// variable was already set. Process elements come first and each element appears
+// once, at its first position, so the process value wins on conflict while the two
+// lists are merged. If neither source has an element, it returns fallback.
+func GetEnvStringSliceMerged(key string, fallback []string) []string {
+	merged, _ := TryGetEnvStringSlice(key)
+	dotenvMu.Lock()
+	v, ok := dotenvValues[key]
+	dotenvMu.Unlock()
+	if ok {
+		merged = append(merged, splitList(v, ",")...)
+	}
+
+	var out []string
+	for _, e := range merged {
+		if !slices.Contains(out, e) {
+			out = append(out, e)
+		}
+	}
+	var err error
+	if len(out) == 0 {
+		err = notFoundError(key)
+	}
+	return orFallback(key, out, err, fallback)
+}
+
+// readDotenvFile opens path and parses it with Parse.
+func readDotenvFile(path string) (map[string]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to open env file: %w", err)
+	}
+	defer f.Close()
+
+	vars, err := Parse(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return vars, nil
+}
+
+// Parse reads dotenv-style content from r and returns its variables without touching
+// the process environment, e.g. to merge or diff sources in memory before applying
+// them. It follows the same rules as LoadFile: blank and '#' lines are ignored, an
+// "export " prefix is allowed, matching quotes are stripped, and a key that repeats
+// takes its last value. A malformed line is an error naming its 1-based line number.
+func Parse(r io.Reader) (map[string]string, error) {
+	vars := make(map[string]string)
+	sc := bufio.NewScanner(r)
+	for n := 1; sc.Scan(); n++ {
+		line := strings.TrimSpace(sc.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if rest, ok := strings.CutPrefix(line, "export "); ok {
+			line = strings.TrimSpace(rest)
+		}
+
+		k, v, ok := strings.Cut(line, "=")
+		k = strings.TrimSpace(k)
+		if !ok || k == "" || strings.ContainsAny(k, " \t") {
+			return nil, fmt.Errorf("line %d: malformed line %q, expected KEY=VALUE", n, line)
+		}
+		vars[k] = unquoteDotenv(strings.TrimSpace(v))
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+	return vars, nil
+}
+
+// unquoteDotenv strips one pair of matching single or double quotes around v.
+func unquoteDotenv(v string) string {
+	if len(v) >= 2 && (v[0] == '"' || v[0] == '\'') && v[len(v)-1] == v[0] {
+		return v[1 : len(v)-1]
+	}
+	return v
+}
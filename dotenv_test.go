@@ -0,0 +1,272 @@
+package goenv_test
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/battlej07/goenv"
+)
+
+/* ---------- helpers ---------- */
+
+// unsetForTest unsets keys for the duration of the test and restores them afterwards,
+// so variables set by the loaders do not leak into other tests.
+func unsetForTest(t *testing.T, keys ...string) {
+	t.Helper()
+	for _, k := range keys {
+		t.Setenv(k, "")
+		if err := os.Unsetenv(k); err != nil {
+			t.Fatalf("unsetting %s: %v", k, err)
+		}
+	}
+}
+
+/* ---------- LoadFile (dotenv) ---------- */
+
+func TestLoadFile(t *testing.T) {
+	unsetForTest(t, "DOTENV_PLAIN", "DOTENV_DOUBLE", "DOTENV_SINGLE", "DOTENV_EXPORTED", "DOTENV_HASH", "DOTENV_EMPTY", "DOTENV_EXISTING")
+	t.Setenv("DOTENV_EXISTING", "from-env")
+
+	path := writeTempFile(t, strings.Join([]string{
+		"# comment",
+		"",
+		"DOTENV_PLAIN=plain value",
+		`DOTENV_DOUBLE="double quoted "`,
+		"DOTENV_SINGLE='single # not a comment'",
+		"export DOTENV_EXPORTED=yes",
+		"  DOTENV_HASH = a#b  ",
+		"DOTENV_EMPTY=",
+		"DOTENV_EXISTING=from-file",
+	}, "\n"))
+
+	if err := goenv.LoadFile(path); err != nil {
+		t.Fatalf("LoadFile() failed: %v", err)
+	}
+
+	want := map[string]string{
+		"DOTENV_PLAIN":    "plain value",
+		"DOTENV_DOUBLE":   "double quoted ",
+		"DOTENV_SINGLE":   "single # not a comment",
+		"DOTENV_EXPORTED": "yes",
+		"DOTENV_HASH":     "a#b",
+		"DOTENV_EMPTY":    "",
+		"DOTENV_EXISTING": "from-env",
+	}
+	for k, v := range want {
+		got, ok := os.LookupEnv(k)
+		if !ok || got != v {
+			t.Errorf("%s = %q (set %v), want %q", k, got, ok, v)
+		}
+	}
+}
+
+func TestLoadFileFirstFileWins(t *testing.T) {
+	unsetForTest(t, "DOTENV_SHARED", "DOTENV_SECOND")
+	first := writeTempFile(t, "DOTENV_SHARED=first\n")
+	second := writeTempFile(t, "DOTENV_SHARED=second\nDOTENV_SECOND=2\n")
+
+	if err := goenv.LoadFile(first, second); err != nil {
+		t.Fatalf("LoadFile() failed: %v", err)
+	}
+	if got := os.Getenv("DOTENV_SHARED"); got != "first" {
+		t.Errorf("DOTENV_SHARED = %q, want first", got)
+	}
+	if got := os.Getenv("DOTENV_SECOND"); got != "2" {
+		t.Errorf("DOTENV_SECOND = %q, want 2", got)
+	}
+}
+
+func TestLoadFileErrors(t *testing.T) {
+	t.Run("missing listed file", func(t *testing.T) {
+		err := goenv.LoadFile(filepath.Join(t.TempDir(), "nope.env"))
+		if !errors.Is(err, fs.ErrNotExist) {
+			t.Errorf("LoadFile() error = %v, want fs.ErrNotExist", err)
+		}
+	})
+
+	t.Run("malformed line", func(t *testing.T) {
+		unsetForTest(t, "DOTENV_OK")
+		path := writeTempFile(t, "DOTENV_OK=1\n\nNOT A VAR\n")
+		err := goenv.LoadFile(path)
+		if err == nil || !strings.Contains(err.Error(), `line 3: malformed line "NOT A VAR"`) {
+			t.Errorf("LoadFile() error = %v, want malformed line 3", err)
+		}
+	})
+}
+
+func TestLoadFileDefault(t *testing.T) {
+	unsetForTest(t, "DOTENV_DEFAULT")
+	dir := t.TempDir()
+	t.Chdir(dir)
+
+	if err := goenv.LoadFile(); err != nil {
+		t.Fatalf("LoadFile() without .env failed: %v", err)
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, ".env"), []byte("DOTENV_DEFAULT=loaded\n"), 0o600); err != nil {
+		t.Fatalf("writing .env: %v", err)
+	}
+	if err := goenv.LoadFile(); err != nil {
+		t.Fatalf("LoadFile() failed: %v", err)
+	}
+	if got := os.Getenv("DOTENV_DEFAULT"); got != "loaded" {
+		t.Errorf("DOTENV_DEFAULT = %q, want loaded", got)
+	}
+}
+
+/* ---------- OverloadFile (dotenv) ---------- */
+
+func TestOverloadFile(t *testing.T) {
+	unsetForTest(t, "DOTENV_NEW")
+	t.Setenv("DOTENV_PRESET", "from-env")
+	path := writeTempFile(t, "DOTENV_PRESET='from-file'\n# comment\nexport DOTENV_NEW=\"new\"\n")
+
+	if err := goenv.LoadFile(path); err != nil {
+		t.Fatalf("LoadFile() failed: %v", err)
+	}
+	if got := os.Getenv("DOTENV_PRESET"); got != "from-env" {
+		t.Errorf("after LoadFile() DOTENV_PRESET = %q, want from-env", got)
+	}
+
+	if err := goenv.OverloadFile(path); err != nil {
+		t.Fatalf("OverloadFile() failed: %v", err)
+	}
+	if got := os.Getenv("DOTENV_PRESET"); got != "from-file" {
+		t.Errorf("after OverloadFile() DOTENV_PRESET = %q, want from-file", got)
+	}
+	if got := os.Getenv("DOTENV_NEW"); got != "new" {
+		t.Errorf("after OverloadFile() DOTENV_NEW = %q, want new", got)
+	}
+}
+
+func TestOverloadFileLastFileWins(t *testing.T) {
+	unsetForTest(t, "DOTENV_SHARED")
+	first := writeTempFile(t, "DOTENV_SHARED=first\n")
+	second := writeTempFile(t, "DOTENV_SHARED=second\n")
+
+	if err := goenv.OverloadFile(first, second); err != nil {
+		t.Fatalf("OverloadFile() failed: %v", err)
+	}
+	if got := os.Getenv("DOTENV_SHARED"); got != "second" {
+		t.Errorf("DOTENV_SHARED = %q, want second", got)
+	}
+	if err := goenv.OverloadFile(filepath.Join(t.TempDir(), "nope.env")); !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("OverloadFile() error = %v, want fs.ErrNotExist", err)
+	}
+}
+
+/* ---------- GetEnvStringSliceMerged (dotenv) ---------- */
+
+func TestGetEnvStringSliceMerged(t *testing.T) {
+	tests := []struct {
+		name     string
+		process  string // set before loading; empty means unset
+		file     string
+		unset    bool // unset the variable after loading
+		fallback []string
+		want     []string
+	}{
+		{"overlapping values", "c,d,a", "a,b,c", false, nil, []string{"c", "d", "a", "b"}},
+		{"process duplicates", "a,a,b", "b,c,c", false, nil, []string{"a", "b", "c"}},
+		{"file only", "", "a,b", false, nil, []string{"a", "b"}},
+		{"file only after unset", "", "a,b", true, nil, []string{"a", "b"}},
+		{"process only", "x,y", "", false, nil, []string{"x", "y"}},
+		{"neither has elements", "", " , ", false, []string{"fb"}, []string{"fb"}},
+	}
+
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key := fmt.Sprintf("DOTENV_MERGED_%d", i)
+			unsetForTest(t, key)
+			if tt.process != "" {
+				t.Setenv(key, tt.process)
+			}
+			content := ""
+			if tt.file != "" {
+				content = key + "=" + tt.file + "\n"
+			}
+			if err := goenv.LoadFile(writeTempFile(t, content)); err != nil {
+				t.Fatalf("LoadFile() failed: %v", err)
+			}
+			if tt.unset {
+				os.Unsetenv(key)
+			}
+			if got := goenv.GetEnvStringSliceMerged(key, tt.fallback); !slices.Equal(got, tt.want) {
+				t.Errorf("GetEnvStringSliceMerged() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetEnvStringSliceMergedOverloadFile(t *testing.T) {
+	unsetForTest(t, "DOTENV_MERGED_OVER")
+	t.Setenv("DOTENV_MERGED_OVER", "p")
+	first := writeTempFile(t, "DOTENV_MERGED_OVER=a,b\n")
+	second := writeTempFile(t, "DOTENV_MERGED_OVER=b,c\n")
+
+	if err := goenv.OverloadFile(first, second); err != nil {
+		t.Fatalf("OverloadFile() failed: %v", err)
+	}
+	// The process value is the last file's, and the file value recorded is too.
+	want := []string{"b", "c"}
+	if got := goenv.GetEnvStringSliceMerged("DOTENV_MERGED_OVER", nil); !slices.Equal(got, want) {
+		t.Errorf("GetEnvStringSliceMerged() = %q, want %q", got, want)
+	}
+}
+
+/* ---------- Parse (dotenv) ---------- */
+
+func TestParse(t *testing.T) {
+	unsetForTest(t, "DOTENV_PARSE_A")
+	input := strings.Join([]string{
+		"# leading comment",
+		"DOTENV_PARSE_A=first",
+		"export B='two words'",
+		`C="quoted"`,
+		"",
+		"DOTENV_PARSE_A=second",
+	}, "\n")
+
+	got, err := goenv.Parse(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("Parse() failed: %v", err)
+	}
+	want := map[string]string{"DOTENV_PARSE_A": "second", "B": "two words", "C": "quoted"}
+	if len(got) != len(want) {
+		t.Errorf("Parse() = %v, want %v", got, want)
+	}
+	for k, v := range want {
+		if got[k] != v {
+			t.Errorf("Parse()[%q] = %q, want %q", k, got[k], v)
+		}
+	}
+	if _, ok := os.LookupEnv("DOTENV_PARSE_A"); ok {
+		t.Error("Parse() modified the process environment")
+	}
+}
+
+func TestParseMalformed(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		wantErr string
+	}{
+		{name: "no equals", input: "A=1\n# c\nJUSTAKEY\n", wantErr: "line 3: malformed line \"JUSTAKEY\""},
+		{name: "empty key", input: "=value", wantErr: "line 1: malformed line"},
+		{name: "space in key", input: "A=1\nMY KEY=2", wantErr: "line 2: malformed line"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := goenv.Parse(strings.NewReader(tt.input))
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Parse() error = %v, want it to contain %q", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,101 @@
+package goenv
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+)
+
+// GetEnvBytesBase64 returns the bytes of the standard base64-encoded environment
+// variable named by key, e.g. "aGVsbG8=". If the variable is unset, empty, or not
+// valid base64, it returns fallback.
+func GetEnvBytesBase64(key string, fallback []byte) []byte {
+	v, err := TryGetEnvBytesBase64(key)
+	return orFallback(key, v, err, fallback)
+}
+
+// GetEnvBytesBase64URL is like GetEnvBytesBase64 but uses the URL-safe alphabet, in
+// which '-' and '_' replace '+' and '/'.
+func GetEnvBytesBase64URL(key string, fallback []byte) []byte {
+	v, err := TryGetEnvBytesBase64URL(key)
+	return orFallback(key, v, err, fallback)
+}
+
+// GetEnvBytesHex returns the bytes of the hex-encoded environment variable named by
+// key, e.g. "00112233aabbcc". If the variable is unset, empty, or not valid hex, it
+// returns fallback.
+func GetEnvBytesHex(key string, fallback []byte) []byte {
+	v, err := TryGetEnvBytesHex(key)
+	return orFallback(key, v, err, fallback)
+}
+
+// TryGetEnvBytesBase64 returns the bytes of the environment variable named by key,
+// decoded with base64.StdEncoding. It returns an error if the variable is unset,
+// empty, or not valid padded base64.
+func TryGetEnvBytesBase64(key string) ([]byte, error) {
+	return tryGetEnvBase64(key, base64.StdEncoding)
+}
+
+// TryGetEnvBytesBase64URL returns the bytes of the environment variable named by key,
+// decoded with base64.URLEncoding. It returns an error if the variable is unset,
+// empty, or not valid padded URL-safe base64.
+func TryGetEnvBytesBase64URL(key string) ([]byte, error) {
+	return tryGetEnvBase64(key, base64.URLEncoding)
+}
+
+// TryGetEnvBytesHex returns the bytes of the environment variable named by key, decoded
+// with hex.DecodeString; upper- and lower-case digits are both accepted. It returns an
+// error if the variable is unset, empty, has odd length, or contains a non-hex character.
+func TryGetEnvBytesHex(key string) ([]byte, error) {
+	v, err := tryGetEnv(key, "[]byte")
+	if err != nil {
+		return nil, err
+	}
+	b, err := hex.DecodeString(v)
+	if err != nil {
+		return nil, parseErrorf(key, "unable to decode value as hex: %w", err)
+	}
+	return b, nil
+}
+
+// MustGetEnvBytesBase64 returns the bytes of the standard base64-encoded environment
+// variable named by key. It panics if the variable is unset, empty, or not valid base64.
+func MustGetEnvBytesBase64(key string) []byte {
+	v, err := TryGetEnvBytesBase64(key)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// MustGetEnvBytesBase64URL returns the bytes of the URL-safe base64-encoded environment
+// variable named by key. It panics if the variable is unset, empty, or not valid base64.
+func MustGetEnvBytesBase64URL(key string) []byte {
+	v, err := TryGetEnvBytesBase64URL(key)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// MustGetEnvBytesHex returns the bytes of the hex-encoded environment variable named by
+// key. It panics if the variable is unset, empty, or not valid hex.
+func MustGetEnvBytesHex(key string) []byte {
+	v, err := TryGetEnvBytesHex(key)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// tryGetEnvBase64 decodes the value of key with enc.
+func tryGetEnvBase64(key string, enc *base64.Encoding) ([]byte, error) {
+	v, err := tryGetEnv(key, "[]byte")
+	if err != nil {
+		return nil, err
+	}
+	b, err := enc.DecodeString(v)
+	if err != nil {
+		return nil, parseErrorf(key, "unable to decode value as base64: %w", err)
+	}
+	return b, nil
+}
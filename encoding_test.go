@@ -0,0 +1,128 @@
+package goenv_test
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/battlej07/goenv"
+)
+
+/* ---------- base64 ---------- */
+
+func TestTryGetEnvBytesBase64(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		url     bool
+		want    []byte
+		wantErr bool
+	}{
+		{name: "std", value: "aGVsbG8=", want: []byte("hello")},
+		{name: "std with +/", value: "+/8=", want: []byte{0xfb, 0xff}},
+		{name: "url-safe", value: "-_8=", url: true, want: []byte{0xfb, 0xff}},
+		{name: "url alphabet in std", value: "-_8=", wantErr: true},
+		{name: "std alphabet in url", value: "+/8=", url: true, wantErr: true},
+		{name: "corrupt", value: "aGVsbG8", wantErr: true},
+		{name: "corrupt url", value: "a$b=", url: true, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("ENV_B64", tt.value)
+			try := goenv.TryGetEnvBytesBase64
+			if tt.url {
+				try = goenv.TryGetEnvBytesBase64URL
+			}
+			got, err := try("ENV_B64")
+			if tt.wantErr {
+				if !errors.Is(err, goenv.ErrParse) {
+					t.Fatalf("error = %v, want ErrParse", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !bytes.Equal(got, tt.want) {
+				t.Errorf("got %x, want %x", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetEnvBytesBase64(t *testing.T) {
+	fallback := []byte("fallback")
+	t.Setenv("ENV_B64", "aGk=")
+	if got := goenv.GetEnvBytesBase64("ENV_B64", fallback); string(got) != "hi" {
+		t.Errorf("GetEnvBytesBase64() = %q, want hi", got)
+	}
+	if got := goenv.GetEnvBytesBase64URL("ENV_B64", fallback); string(got) != "hi" {
+		t.Errorf("GetEnvBytesBase64URL() = %q, want hi", got)
+	}
+	t.Setenv("ENV_B64", "!!")
+	if got := goenv.GetEnvBytesBase64("ENV_B64", fallback); !bytes.Equal(got, fallback) {
+		t.Errorf("GetEnvBytesBase64() = %q, want fallback", got)
+	}
+	if got := goenv.GetEnvBytesBase64URL("ENV_B64", fallback); !bytes.Equal(got, fallback) {
+		t.Errorf("GetEnvBytesBase64URL() = %q, want fallback", got)
+	}
+	func() {
+		defer expectPanic(t, true)()
+		goenv.MustGetEnvBytesBase64("ENV_B64")
+	}()
+	func() {
+		defer expectPanic(t, true)()
+		goenv.MustGetEnvBytesBase64URL("ENV_B64")
+	}()
+}
+
+/* ---------- hex ---------- */
+
+func TestTryGetEnvBytesHex(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		want    []byte
+		wantErr string
+	}{
+		{name: "even length", value: "00112233aabbcc", want: []byte{0x00, 0x11, 0x22, 0x33, 0xaa, 0xbb, 0xcc}},
+		{name: "upper case", value: "DEADBEEF", want: []byte{0xde, 0xad, 0xbe, 0xef}},
+		{name: "odd length", value: "abc", wantErr: "odd length"},
+		{name: "non-hex character", value: "0g", wantErr: "invalid byte"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("ENV_HEX", tt.value)
+			got, err := goenv.TryGetEnvBytesHex("ENV_HEX")
+			if tt.wantErr != "" {
+				if !errors.Is(err, goenv.ErrParse) || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("TryGetEnvBytesHex() error = %v, want a parse error containing %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("TryGetEnvBytesHex() failed: %v", err)
+			}
+			if !bytes.Equal(got, tt.want) {
+				t.Errorf("TryGetEnvBytesHex() = %x, want %x", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetEnvBytesHex(t *testing.T) {
+	fallback := []byte{0xff}
+	t.Setenv("ENV_HEX", "0102")
+	if got := goenv.GetEnvBytesHex("ENV_HEX", fallback); !bytes.Equal(got, []byte{1, 2}) {
+		t.Errorf("GetEnvBytesHex() = %x, want 0102", got)
+	}
+	t.Setenv("ENV_HEX", "010")
+	if got := goenv.GetEnvBytesHex("ENV_HEX", fallback); !bytes.Equal(got, fallback) {
+		t.Errorf("GetEnvBytesHex() = %x, want fallback", got)
+	}
+	func() {
+		defer expectPanic(t, true)()
+		goenv.MustGetEnvBytesHex("ENV_HEX")
+	}()
+}
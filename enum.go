@@ -0,0 +1,65 @@
+package goenv
+
+import "strings"
+
+// GetEnvEnum returns the value of the environment variable named by key if it is one
+// of allowed, compared exactly. If the variable is unset, empty, or not in allowed,
+// it returns fallback.
+func GetEnvEnum(key string, allowed []string, fallback string) string {
+	v, err := TryGetEnvEnum(key, allowed)
+	return orFallback(key, v, err, fallback)
+}
+
+// GetEnvEnumFold is like GetEnvEnum but compares case-insensitively, so "INFO"
+// matches an allowed "info". It returns the matching entry as spelled in allowed.
+func GetEnvEnumFold(key string, allowed []string, fallback string) string {
+	v, err := TryGetEnvEnumFold(key, allowed)
+	return orFallback(key, v, err, fallback)
+}
+
+// TryGetEnvEnum returns the value of the environment variable named by key if it is
+// one of allowed, compared exactly. It returns an error listing the allowed values if
+// the value is not among them, or if the variable is unset or empty.
+func TryGetEnvEnum(key string, allowed []string) (string, error) {
+	return tryGetEnvEnum(key, allowed, func(a, b string) bool { return a == b })
+}
+
+// TryGetEnvEnumFold is like TryGetEnvEnum but compares case-insensitively. It returns
+// the matching entry as spelled in allowed.
+func TryGetEnvEnumFold(key string, allowed []string) (string, error) {
+	return tryGetEnvEnum(key, allowed, strings.EqualFold)
+}
+
+// MustGetEnvEnum returns the value of the environment variable named by key if it is
+// one of allowed. It panics if the variable is unset, empty, or not in allowed.
+func MustGetEnvEnum(key string, allowed []string) string {
+	v, err := TryGetEnvEnum(key, allowed)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// MustGetEnvEnumFold is like MustGetEnvEnum but compares case-insensitively.
+func MustGetEnvEnumFold(key string, allowed []string) string {
+	v, err := TryGetEnvEnumFold(key, allowed)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// tryGetEnvEnum returns the first entry of allowed that match reports equal to the
+// value of key.
+func tryGetEnvEnum(key string, allowed []string, match func(a, b string) bool) (string, error) {
+	v, err := TryGetEnv(key)
+	if err != nil {
+		return "", err
+	}
+	for _, a := range allowed {
+		if match(v, a) {
+			return a, nil
+		}
+	}
+	return "", parseErrorf(key, "value %q not in %v", v, allowed)
+}
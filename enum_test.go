@@ -0,0 +1,84 @@
+package goenv_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/battlej07/goenv"
+)
+
+/* ---------- enum ---------- */
+
+var logLevels = []string{"debug", "info", "warn", "error"}
+
+func TestTryGetEnvEnum(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		fold    bool
+		want    string
+		wantErr string
+	}{
+		{name: "valid", value: "warn", want: "warn"},
+		{name: "invalid", value: "warn2", wantErr: `value "warn2" not in [debug info warn error]`},
+		{name: "case mismatch", value: "INFO", wantErr: `value "INFO" not in [debug info warn error]`},
+		{name: "empty", value: "", wantErr: "unable to find"},
+		{name: "fold valid", value: "INFO", fold: true, want: "info"},
+		{name: "fold mixed case", value: "Error", fold: true, want: "error"},
+		{name: "fold invalid", value: "trace", fold: true, wantErr: `value "trace" not in [debug info warn error]`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("LOG_LEVEL", tt.value)
+			try := goenv.TryGetEnvEnum
+			if tt.fold {
+				try = goenv.TryGetEnvEnumFold
+			}
+			got, err := try("LOG_LEVEL", logLevels)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetEnvEnum(t *testing.T) {
+	t.Setenv("LOG_LEVEL", "debug")
+	if got := goenv.GetEnvEnum("LOG_LEVEL", logLevels, "info"); got != "debug" {
+		t.Errorf("GetEnvEnum() = %q, want debug", got)
+	}
+	t.Setenv("LOG_LEVEL", "DEBUG")
+	if got := goenv.GetEnvEnum("LOG_LEVEL", logLevels, "info"); got != "info" {
+		t.Errorf("GetEnvEnum() = %q, want fallback info", got)
+	}
+	if got := goenv.GetEnvEnumFold("LOG_LEVEL", logLevels, "info"); got != "debug" {
+		t.Errorf("GetEnvEnumFold() = %q, want debug", got)
+	}
+	t.Setenv("LOG_LEVEL", "verbose")
+	if got := goenv.GetEnvEnumFold("LOG_LEVEL", logLevels, "info"); got != "info" {
+		t.Errorf("GetEnvEnumFold() = %q, want fallback info", got)
+	}
+}
+
+func TestMustGetEnvEnum(t *testing.T) {
+	t.Setenv("LOG_LEVEL", "WARN")
+	func() {
+		defer expectPanic(t, true)()
+		goenv.MustGetEnvEnum("LOG_LEVEL", logLevels)
+	}()
+	func() {
+		defer expectPanic(t, false)()
+		if got := goenv.MustGetEnvEnumFold("LOG_LEVEL", logLevels); got != "warn" {
+			t.Errorf("MustGetEnvEnumFold() = %q, want warn", got)
+		}
+	}()
+}
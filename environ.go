@@ -0,0 +1,74 @@
+package goenv
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// GetEnvMatchingKeys returns every environment variable whose key matches pattern,
+// keyed by variable name. Variables set to an empty value are included.
+// It returns an empty map if no key matches.
+func GetEnvMatchingKeys(pattern *regexp.Regexp) map[string]string {
+	m := make(map[string]string)
+	for _, kv := range os.Environ() {
+		k, v, _ := strings.Cut(kv, "=")
+		if pattern.MatchString(k) {
+			m[k] = v
+		}
+	}
+	return m
+}
+
+// ConfigFingerprint returns the hex-encoded SHA-256 of the raw values of the environment
+// variables named by keys, hashed in key order as NUL-terminated key and value fields
+// with a presence byte before each value. An unset variable therefore differs from one
+// set to any value, including the empty string.
+// The result depends only on the set of keys and their values, not on argument order,
+// which makes it suitable for detecting configuration changes across reloads.
+func ConfigFingerprint(keys ...string) string {
+	sorted := slices.Clone(keys)
+	slices.Sort(sorted)
+	sorted = slices.Compact(sorted)
+
+	h := sha256.New()
+	for _, k := range sorted {
+		// Environment keys and values cannot contain NUL, so the fields are unambiguous.
+		v, ok := os.LookupEnv(k)
+		presence := "0"
+		if ok {
+			presence = "1"
+		}
+		h.Write([]byte(k + "\x00" + presence + v + "\x00"))
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// GetEnvGroupedByPrefix collects the environment variables whose key starts with prefix
+// and groups them by the next key segment. The rest of each key is split on the first
+// sep into a group and a subkey, so with prefix "TAG_" and sep "_", TAG_A_X=1 and
+// TAG_B_Z=2 yield {"A": {"X": "1"}, "B": {"Z": "2"}}. Keys whose rest contains no sep,
+// or has an empty group or subkey, are skipped. It returns an empty map if nothing
+// matches.
+func GetEnvGroupedByPrefix(prefix, sep string) map[string]map[string]string {
+	groups := make(map[string]map[string]string)
+	for _, kv := range os.Environ() {
+		k, v, _ := strings.Cut(kv, "=")
+		rest, ok := strings.CutPrefix(k, prefix)
+		if !ok {
+			continue
+		}
+		group, sub, ok := strings.Cut(rest, sep)
+		if !ok || group == "" || sub == "" {
+			continue
+		}
+		if groups[group] == nil {
+			groups[group] = make(map[string]string)
+		}
+		groups[group][sub] = v
+	}
+	return groups
+}
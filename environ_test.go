@@ -0,0 +1,102 @@
+package goenv_test
+
+import (
+	"maps"
+	"regexp"
+	"testing"
+
+	"github.com/battlej07/goenv"
+)
+
+/* ---------- matching keys ---------- */
+
+func TestGetEnvMatchingKeys(t *testing.T) {
+	t.Setenv("PLUGIN_1", "auth")
+	t.Setenv("PLUGIN_22", "cache")
+	t.Setenv("PLUGIN_3", "")
+	t.Setenv("PLUGIN_X", "ignored")
+	t.Setenv("MYPLUGIN_4", "ignored")
+	t.Setenv("PLUGIN_5_NAME", "ignored")
+
+	got := goenv.GetEnvMatchingKeys(regexp.MustCompile(`^PLUGIN_\d+$`))
+	want := map[string]string{"PLUGIN_1": "auth", "PLUGIN_22": "cache", "PLUGIN_3": ""}
+	if !maps.Equal(got, want) {
+		t.Errorf("GetEnvMatchingKeys() = %v, want %v", got, want)
+	}
+
+	if got := goenv.GetEnvMatchingKeys(regexp.MustCompile(`^NO_SUCH_PREFIX_`)); len(got) != 0 {
+		t.Errorf("GetEnvMatchingKeys() = %v, want empty", got)
+	}
+}
+
+/* ---------- config fingerprint ---------- */
+
+func TestConfigFingerprint(t *testing.T) {
+	t.Setenv("FP_HOST", "localhost")
+	t.Setenv("FP_PORT", "8080")
+	keys := []string{"FP_HOST", "FP_PORT", "FP_MISSING"}
+
+	base := goenv.ConfigFingerprint(keys...)
+	if len(base) != 64 {
+		t.Fatalf("ConfigFingerprint() = %q, want 64 hex characters", base)
+	}
+	if got := goenv.ConfigFingerprint(keys...); got != base {
+		t.Errorf("ConfigFingerprint() not stable: %s != %s", got, base)
+	}
+	if got := goenv.ConfigFingerprint("FP_MISSING", "FP_PORT", "FP_HOST", "FP_HOST"); got != base {
+		t.Errorf("ConfigFingerprint() depends on key order or repeats: %s != %s", got, base)
+	}
+
+	changes := []struct {
+		name string
+		key  string
+		val  string
+	}{
+		{name: "value changed", key: "FP_PORT", val: "9090"},
+		{name: "unset -> empty", key: "FP_MISSING", val: ""},
+		{name: "unset -> set", key: "FP_MISSING", val: "x"},
+		{name: "unset -> literal <unset>", key: "FP_MISSING", val: "<unset>"},
+		{name: "unset -> presence-like value", key: "FP_MISSING", val: "0"},
+	}
+	for _, c := range changes {
+		t.Run(c.name, func(t *testing.T) {
+			t.Setenv(c.key, c.val)
+			if got := goenv.ConfigFingerprint(keys...); got == base {
+				t.Errorf("ConfigFingerprint() unchanged after setting %s=%q", c.key, c.val)
+			}
+		})
+	}
+	if got := goenv.ConfigFingerprint(keys...); got != base {
+		t.Errorf("ConfigFingerprint() = %s after restoring, want %s", got, base)
+	}
+}
+
+/* ---------- grouped by prefix ---------- */
+
+func TestGetEnvGroupedByPrefix(t *testing.T) {
+	t.Setenv("GRPTAG_A_X", "1")
+	t.Setenv("GRPTAG_A_Y", "2")
+	t.Setenv("GRPTAG_B_Z", "3")
+	t.Setenv("GRPTAG_B_Z_DEEP", "4")
+	t.Setenv("GRPTAG_C", "no subkey")
+	t.Setenv("GRPTAG__EMPTY", "no group")
+	t.Setenv("OTHER_A_X", "other prefix")
+
+	got := goenv.GetEnvGroupedByPrefix("GRPTAG_", "_")
+	want := map[string]map[string]string{
+		"A": {"X": "1", "Y": "2"},
+		"B": {"Z": "3", "Z_DEEP": "4"},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("GetEnvGroupedByPrefix() = %v, want %v", got, want)
+	}
+	for g, sub := range want {
+		if !maps.Equal(got[g], sub) {
+			t.Errorf("GetEnvGroupedByPrefix()[%q] = %v, want %v", g, got[g], sub)
+		}
+	}
+
+	if got := goenv.GetEnvGroupedByPrefix("NOPE_GRPTAG_", "_"); len(got) != 0 {
+		t.Errorf("GetEnvGroupedByPrefix() = %v, want empty", got)
+	}
+}
@@ -0,0 +1,118 @@
+package goenv
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+)
+
+var (
+	// ErrNotFound is matched by errors.Is for errors caused by a variable that is unset
+	// or empty, or a list variable that contains no elements.
+	ErrNotFound = errors.New("env variable not found")
+
+	// ErrParse is matched by errors.Is for errors caused by a variable that is present
+	// but cannot be parsed or fails validation.
+	ErrParse = errors.New("unable to parse env variable")
+)
+
+// Values of EnvError.Kind.
+const (
+	EnvErrorNotFound = "not_found" // matches ErrNotFound
+	EnvErrorParse    = "parse"     // matches ErrParse
+)
+
+// EnvError is the error returned by the TryGetEnv* functions. It records the variable
+// that failed and its raw value, so callers can inspect them without parsing the
+// message:
+//
+//	var ee *goenv.EnvError
+//	if errors.As(err, &ee) {
+//		log.Printf("key=%s kind=%s value=%q", ee.Key, ee.Kind, ee.Value)
+//	}
+//
+// errors.Is matches ErrNotFound or ErrParse according to Kind, as well as anything
+// Err wraps.
+type EnvError struct {
+	Key   string // name of the environment variable
+	Value string // raw value at the time of the failure, empty if unset
+	Kind  string // EnvErrorNotFound or EnvErrorParse
+	Err   error  // underlying error, which carries the message
+}
+
+// Error returns the message of the underlying error.
+func (e *EnvError) Error() string {
+	if e.Err == nil {
+		return fmt.Sprintf("env variable with key %s: %s", e.Key, e.Kind)
+	}
+	return e.Err.Error()
+}
+
+// Unwrap returns the underlying error.
+func (e *EnvError) Unwrap() error { return e.Err }
+
+// Is reports whether target is the sentinel matching e.Kind.
+func (e *EnvError) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return e.Kind == EnvErrorNotFound
+	case ErrParse:
+		return e.Kind == EnvErrorParse
+	}
+	return false
+}
+
+// notFoundError reports that the variable named by key is unset or empty.
+func notFoundError(key string) error {
+	return &EnvError{
+		Key:   key,
+		Value: os.Getenv(key),
+		Kind:  EnvErrorNotFound,
+		Err:   fmt.Errorf("unable to find env variable with key %s", key),
+	}
+}
+
+// notFoundAnyError reports that none of the variables named by keys is set. Its Key is
+// the first, preferred key.
+func notFoundAnyError(keys []string) error {
+	var key string
+	if len(keys) > 0 {
+		key = keys[0]
+	}
+	return &EnvError{
+		Key:  key,
+		Kind: EnvErrorNotFound,
+		Err:  fmt.Errorf("unable to find any env variable with keys %s", strings.Join(keys, ", ")),
+	}
+}
+
+// noElementsError reports that the list variable named by key contains no elements.
+func noElementsError(key string) error {
+	return &EnvError{
+		Key:   key,
+		Value: os.Getenv(key),
+		Kind:  EnvErrorNotFound,
+		Err:   fmt.Errorf("env variable with key %s contains no elements", key),
+	}
+}
+
+// missingRequiredError reports that the required variables named by keys are unset or
+// empty. Its Key is the first missing key.
+func missingRequiredError(keys []string) error {
+	return &EnvError{
+		Key:  keys[0],
+		Kind: EnvErrorNotFound,
+		Err:  fmt.Errorf("missing required env variables: %s", strings.Join(keys, ", ")),
+	}
+}
+
+// parseError reports that the value of the variable named by key is invalid.
+func parseError(key string, err error) error {
+	return &EnvError{Key: key, Value: os.Getenv(key), Kind: EnvErrorParse, Err: err}
+}
+
+// parseErrorf is parseError with a message formatted as by fmt.Errorf.
+func parseErrorf(key, format string, a ...any) error {
+	return parseError(key, fmt.Errorf(format, a...))
+}
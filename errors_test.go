@@ -0,0 +1,132 @@
+package goenv_test
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/battlej07/goenv"
+)
+
+/* ---------- sentinel errors ---------- */
+
+func TestSentinelErrors(t *testing.T) {
+	const key = "ENV_SENTINEL"
+	getters := map[string]func() error{
+		"TryGetEnv":            func() error { _, err := goenv.TryGetEnv(key); return err },
+		"TryGetEnvInt":         func() error { _, err := goenv.TryGetEnvInt(key); return err },
+		"TryGetEnvInt16":       func() error { _, err := goenv.TryGetEnvInt16(key); return err },
+		"TryGetEnvUint32":      func() error { _, err := goenv.TryGetEnvUint32(key); return err },
+		"TryGetEnvFloat64":     func() error { _, err := goenv.TryGetEnvFloat64(key); return err },
+		"TryGetEnvBool":        func() error { _, err := goenv.TryGetEnvBool(key); return err },
+		"TryGetEnvTime":        func() error { _, err := goenv.TryGetEnvTime(key); return err },
+		"TryGetEnvDuration":    func() error { _, err := goenv.TryGetEnvDuration(key); return err },
+		"TryGetEnvIntSlice":    func() error { _, err := goenv.TryGetEnvIntSlice(key); return err },
+		"TryGetEnvIntSliceAll": func() error { _, err := goenv.TryGetEnvIntSliceAll(key); return err },
+		"TryGetEnvIntPair":     func() error { _, _, err := goenv.TryGetEnvIntPair(key); return err },
+		"TryGetEnvAs": func() error {
+			_, err := goenv.TryGetEnvAs(key, strconv.Atoi)
+			return err
+		},
+	}
+
+	for name, get := range getters {
+		t.Run(name+" missing", func(t *testing.T) {
+			err := get()
+			if !errors.Is(err, goenv.ErrNotFound) {
+				t.Errorf("%s() error = %v, want ErrNotFound", name, err)
+			}
+			if errors.Is(err, goenv.ErrParse) {
+				t.Errorf("%s() error = %v unexpectedly matches ErrParse", name, err)
+			}
+		})
+		if name == "TryGetEnv" {
+			continue
+		}
+		t.Run(name+" unparseable", func(t *testing.T) {
+			t.Setenv(key, "x!")
+			err := get()
+			if !errors.Is(err, goenv.ErrParse) {
+				t.Errorf("%s() error = %v, want ErrParse", name, err)
+			}
+			if errors.Is(err, goenv.ErrNotFound) {
+				t.Errorf("%s() error = %v unexpectedly matches ErrNotFound", name, err)
+			}
+		})
+	}
+}
+
+func TestSentinelErrorsKeepMessagesAndCauses(t *testing.T) {
+	t.Setenv("ENV_SENTINEL", "99999999999")
+	_, err := goenv.TryGetEnvInt16("ENV_SENTINEL")
+	if want := `unable to convert "99999999999" to int16: value out of range`; err == nil || err.Error() != want {
+		t.Errorf("TryGetEnvInt16() error = %v, want %q", err, want)
+	}
+	if !errors.Is(err, strconv.ErrRange) {
+		t.Errorf("TryGetEnvInt16() error = %v, want it to still wrap strconv.ErrRange", err)
+	}
+
+	_, err = goenv.TryGetEnvDuration("ENV_SENTINEL_MISSING")
+	if want := "unable to find env variable with key ENV_SENTINEL_MISSING"; err == nil || err.Error() != want {
+		t.Errorf("TryGetEnvDuration() error = %v, want %q", err, want)
+	}
+
+	t.Setenv("ENV_SENTINEL", " , ")
+	if _, err := goenv.TryGetEnvStringSlice("ENV_SENTINEL"); !errors.Is(err, goenv.ErrNotFound) {
+		t.Errorf("TryGetEnvStringSlice() error = %v, want ErrNotFound for a list with no elements", err)
+	}
+
+	t.Setenv("ENV_SENTINEL", "5s,x")
+	if _, err := goenv.TryGetEnvDurationSliceSum("ENV_SENTINEL"); !errors.Is(err, goenv.ErrParse) {
+		t.Errorf("TryGetEnvDurationSliceSum() error = %v, want ErrParse", err)
+	}
+}
+
+/* ---------- EnvError ---------- */
+
+func TestEnvErrorFields(t *testing.T) {
+	t.Setenv("ENV_ENVERROR", "12a")
+
+	_, err := goenv.TryGetEnvInt32("ENV_ENVERROR")
+	var ee *goenv.EnvError
+	if !errors.As(err, &ee) {
+		t.Fatalf("TryGetEnvInt32() error = %T %v, want *goenv.EnvError", err, err)
+	}
+	if ee.Key != "ENV_ENVERROR" || ee.Value != "12a" || ee.Kind != goenv.EnvErrorParse {
+		t.Errorf("EnvError = {Key: %q, Value: %q, Kind: %q}, want {ENV_ENVERROR 12a parse}", ee.Key, ee.Value, ee.Kind)
+	}
+	if want := `unable to convert "12a" to int32: invalid syntax`; ee.Error() != want {
+		t.Errorf("EnvError.Error() = %q, want %q", ee.Error(), want)
+	}
+	if !errors.Is(err, strconv.ErrSyntax) || !errors.Is(err, goenv.ErrParse) {
+		t.Errorf("errors.Is() lost the cause or sentinel for %v", err)
+	}
+
+	_, err = goenv.TryGetEnvDuration("ENV_ENVERROR_MISSING")
+	if !errors.As(err, &ee) || ee.Key != "ENV_ENVERROR_MISSING" || ee.Value != "" || ee.Kind != goenv.EnvErrorNotFound {
+		t.Errorf("TryGetEnvDuration() error = %#v, want not_found EnvError", err)
+	}
+}
+
+func TestEnvErrorWrappedByListHelpers(t *testing.T) {
+	t.Setenv("ENV_ENVERROR", "1,two,3")
+	_, err := goenv.TryGetEnvIntSlice("ENV_ENVERROR")
+	var ee *goenv.EnvError
+	if !errors.As(err, &ee) {
+		t.Fatalf("TryGetEnvIntSlice() error = %v, want *goenv.EnvError", err)
+	}
+	if ee.Key != "ENV_ENVERROR" || ee.Value != "1,two,3" || ee.Kind != goenv.EnvErrorParse {
+		t.Errorf("EnvError = %+v", ee)
+	}
+
+	t.Setenv("ENV_ENVERROR", `a="unterminated`)
+	_, err = goenv.TryGetEnvQuotedMap("ENV_ENVERROR")
+	if !errors.As(err, &ee) || ee.Kind != goenv.EnvErrorParse {
+		t.Errorf("TryGetEnvQuotedMap() error = %v, want parse EnvError", err)
+	}
+
+	_, err = goenv.TryGetEnvIndexedSliceTyped("ENV_ENVERROR_IDX", strconv.Atoi)
+	if !errors.As(err, &ee) || ee.Key != "ENV_ENVERROR_IDX0" || ee.Kind != goenv.EnvErrorNotFound {
+		t.Errorf("TryGetEnvIndexedSliceTyped() error = %v, want not_found EnvError for ENV_ENVERROR_IDX0", err)
+	}
+}
@@ -0,0 +1,92 @@
+package goenv
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"time"
+)
+
+// fileChunkSize is the read size used by file-backed getters between cancellation checks.
+const fileChunkSize = 32 * 1024
+
+// GetEnvStringSliceOrFileContext returns the comma-separated elements of the environment
+// variable named by key. If the variable is unset, empty, or contains no elements, it
+// reads the list from filePath instead, one element per line with blank and '#'-comment lines dropped.
+// The file is read in chunks and ctx is checked between reads, so a slow source can be
+// cancelled. It returns an error if the file cannot be read or ctx is done.
+func GetEnvStringSliceOrFileContext(ctx context.Context, key, filePath string) ([]string, error) {
+	if elems, err := TryGetEnvStringSlice(key); err == nil {
+		return elems, nil
+	}
+	markFallback(key)
+
+	data, err := readFileContext(ctx, filePath)
+	if err != nil {
+		return nil, err
+	}
+	return splitLines(string(data)), nil
+}
+
+// GetEnvStringSliceOrFileRetry returns the comma-separated elements of the environment
+// variable named by key. If the variable is unset, empty, or contains no elements, it
+// reads the list from filePath like GetEnvStringSliceOrFileContext, trying up to
+// attempts times with delay between tries so a file that is briefly absent during
+// rotation is still picked up. A file that yields no elements, such as one caught
+// empty mid-write, counts as a failed attempt, matching the rule for the variable. An attempts value below 1 means a single try. It returns the last
+// error if every attempt fails.
+func GetEnvStringSliceOrFileRetry(key, filePath string, attempts int, delay time.Duration) ([]string, error) {
+	if elems, err := TryGetEnvStringSlice(key); err == nil {
+		return elems, nil
+	}
+	markFallback(key)
+
+	var lastErr error
+	for i := 0; i < max(attempts, 1); i++ {
+		if i > 0 {
+			time.Sleep(delay)
+		}
+		data, err := readFileContext(context.Background(), filePath)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		if elems := splitLines(string(data)); len(elems) > 0 {
+			return elems, nil
+		}
+		lastErr = fmt.Errorf("list file %s contains no elements", filePath)
+	}
+	return nil, fmt.Errorf("giving up after %d attempts: %w", max(attempts, 1), lastErr)
+}
+
+// readFileContext reads the whole file at path, checking ctx before every chunk.
+func readFileContext(ctx context.Context, path string) ([]byte, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to open list file %s: %w", path, err)
+	}
+	defer f.Close()
+
+	var buf bytes.Buffer
+	chunk := make([]byte, fileChunkSize)
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		n, err := f.Read(chunk)
+		buf.Write(chunk[:n])
+		if errors.Is(err, io.EOF) {
+			return buf.Bytes(), nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("unable to read list file %s: %w", path, err)
+		}
+	}
+}
@@ -0,0 +1,187 @@
+package goenv_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/battlej07/goenv"
+)
+
+/* ---------- helpers ---------- */
+
+func writeTempFile(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "list.txt")
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("writing temp file: %v", err)
+	}
+	return path
+}
+
+/* ---------- string slice or file (context) ---------- */
+
+func TestGetEnvStringSliceOrFileContext(t *testing.T) {
+	t.Run("env present - file untouched", func(t *testing.T) {
+		t.Setenv("ENV_SLICE_FILE", "a,b")
+		missing := filepath.Join(t.TempDir(), "does-not-exist")
+
+		got, err := goenv.GetEnvStringSliceOrFileContext(context.Background(), "ENV_SLICE_FILE", missing)
+		if err != nil {
+			t.Fatalf("GetEnvStringSliceOrFileContext() failed: %v", err)
+		}
+		if want := []string{"a", "b"}; !slices.Equal(got, want) {
+			t.Errorf("GetEnvStringSliceOrFileContext() = %q, want %q", got, want)
+		}
+	})
+
+	t.Run("file fallback", func(t *testing.T) {
+		path := writeTempFile(t, "# hosts\nalpha\n\nbeta\n")
+
+		got, err := goenv.GetEnvStringSliceOrFileContext(context.Background(), "ENV_SLICE_FILE", path)
+		if err != nil {
+			t.Fatalf("GetEnvStringSliceOrFileContext() failed: %v", err)
+		}
+		if want := []string{"alpha", "beta"}; !slices.Equal(got, want) {
+			t.Errorf("GetEnvStringSliceOrFileContext() = %q, want %q", got, want)
+		}
+	})
+
+	t.Run("env with no elements falls back to file", func(t *testing.T) {
+		t.Setenv("ENV_SLICE_FILE", ",,")
+		path := writeTempFile(t, "alpha\n")
+
+		got, err := goenv.GetEnvStringSliceOrFileContext(context.Background(), "ENV_SLICE_FILE", path)
+		if err != nil {
+			t.Fatalf("GetEnvStringSliceOrFileContext() failed: %v", err)
+		}
+		if want := []string{"alpha"}; !slices.Equal(got, want) {
+			t.Errorf("GetEnvStringSliceOrFileContext() = %q, want %q", got, want)
+		}
+	})
+
+	t.Run("missing file -> err", func(t *testing.T) {
+		missing := filepath.Join(t.TempDir(), "does-not-exist")
+		if _, err := goenv.GetEnvStringSliceOrFileContext(context.Background(), "ENV_SLICE_FILE", missing); err == nil {
+			t.Fatal("GetEnvStringSliceOrFileContext() should have failed with missing file")
+		}
+	})
+
+	t.Run("cancelled context -> err", func(t *testing.T) {
+		path := writeTempFile(t, "alpha\n")
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		_, err := goenv.GetEnvStringSliceOrFileContext(ctx, "ENV_SLICE_FILE", path)
+		if !errors.Is(err, context.Canceled) {
+			t.Fatalf("GetEnvStringSliceOrFileContext() error = %v, want context.Canceled", err)
+		}
+	})
+}
+
+/* ---------- string slice or file (retry) ---------- */
+
+func TestGetEnvStringSliceOrFileRetry(t *testing.T) {
+	t.Run("env present bypasses retries", func(t *testing.T) {
+		t.Setenv("ENV_SLICE_RETRY", "a,b")
+		missing := filepath.Join(t.TempDir(), "does-not-exist")
+
+		start := time.Now()
+		got, err := goenv.GetEnvStringSliceOrFileRetry("ENV_SLICE_RETRY", missing, 5, time.Second)
+		if err != nil {
+			t.Fatalf("GetEnvStringSliceOrFileRetry() failed: %v", err)
+		}
+		if want := []string{"a", "b"}; !slices.Equal(got, want) {
+			t.Errorf("GetEnvStringSliceOrFileRetry() = %q, want %q", got, want)
+		}
+		if elapsed := time.Since(start); elapsed >= time.Second {
+			t.Errorf("GetEnvStringSliceOrFileRetry() took %v, want no retry delay", elapsed)
+		}
+	})
+
+	t.Run("env with no elements falls back to file", func(t *testing.T) {
+		t.Setenv("ENV_SLICE_RETRY", ",,")
+		path := writeTempFile(t, "alpha\n")
+
+		got, err := goenv.GetEnvStringSliceOrFileRetry("ENV_SLICE_RETRY", path, 1, time.Millisecond)
+		if err != nil {
+			t.Fatalf("GetEnvStringSliceOrFileRetry() failed: %v", err)
+		}
+		if want := []string{"alpha"}; !slices.Equal(got, want) {
+			t.Errorf("GetEnvStringSliceOrFileRetry() = %q, want %q", got, want)
+		}
+	})
+
+	t.Run("file appears on a later attempt", func(t *testing.T) {
+		dir := t.TempDir()
+		path := filepath.Join(dir, "list.txt")
+		done := make(chan error, 1)
+		go func() {
+			time.Sleep(20 * time.Millisecond)
+			// Rename into place so no attempt sees a partially written file.
+			tmp := filepath.Join(dir, "list.txt.tmp")
+			if err := os.WriteFile(tmp, []byte("alpha\nbeta\n"), 0o600); err != nil {
+				done <- err
+				return
+			}
+			done <- os.Rename(tmp, path)
+		}()
+
+		got, err := goenv.GetEnvStringSliceOrFileRetry("ENV_SLICE_RETRY", path, 10, 50*time.Millisecond)
+		if werr := <-done; werr != nil {
+			t.Fatalf("writing temp file: %v", werr)
+		}
+		if err != nil {
+			t.Fatalf("GetEnvStringSliceOrFileRetry() failed: %v", err)
+		}
+		if want := []string{"alpha", "beta"}; !slices.Equal(got, want) {
+			t.Errorf("GetEnvStringSliceOrFileRetry() = %q, want %q", got, want)
+		}
+	})
+
+	t.Run("empty file is retried", func(t *testing.T) {
+		path := writeTempFile(t, "")
+		done := make(chan error, 1)
+		go func() {
+			time.Sleep(20 * time.Millisecond)
+			tmp := path + ".tmp"
+			if err := os.WriteFile(tmp, []byte("alpha\n"), 0o600); err != nil {
+				done <- err
+				return
+			}
+			done <- os.Rename(tmp, path)
+		}()
+
+		got, err := goenv.GetEnvStringSliceOrFileRetry("ENV_SLICE_RETRY", path, 10, 50*time.Millisecond)
+		if werr := <-done; werr != nil {
+			t.Fatalf("writing temp file: %v", werr)
+		}
+		if err != nil {
+			t.Fatalf("GetEnvStringSliceOrFileRetry() failed: %v", err)
+		}
+		if want := []string{"alpha"}; !slices.Equal(got, want) {
+			t.Errorf("GetEnvStringSliceOrFileRetry() = %q, want %q", got, want)
+		}
+	})
+
+	t.Run("file stays empty -> err", func(t *testing.T) {
+		path := writeTempFile(t, "# only a comment\n\n")
+		_, err := goenv.GetEnvStringSliceOrFileRetry("ENV_SLICE_RETRY", path, 3, time.Millisecond)
+		if err == nil || !strings.Contains(err.Error(), "no elements") {
+			t.Fatalf("GetEnvStringSliceOrFileRetry() error = %v, want a no elements error", err)
+		}
+	})
+
+	t.Run("all attempts fail -> err", func(t *testing.T) {
+		missing := filepath.Join(t.TempDir(), "does-not-exist")
+		_, err := goenv.GetEnvStringSliceOrFileRetry("ENV_SLICE_RETRY", missing, 3, time.Millisecond)
+		if !errors.Is(err, os.ErrNotExist) {
+			t.Fatalf("GetEnvStringSliceOrFileRetry() error = %v, want os.ErrNotExist", err)
+		}
+	})
+}
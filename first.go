@@ -0,0 +1,40 @@
+package goenv
+
+// GetEnvFirst returns the value of the first environment variable among keys that is
+// set and non-empty, e.g. GetEnvFirst("", "NEW_KEY", "OLD_KEY") prefers NEW_KEY but
+// still honours the deprecated OLD_KEY. If none is set, it returns fallback.
+func GetEnvFirst(fallback string, keys ...string) string {
+	key, v, err := TryGetEnvFirstKey(keys...)
+	if err != nil && len(keys) > 0 {
+		key = keys[0]
+	}
+	return orFallback(key, v, err, fallback)
+}
+
+// TryGetEnvFirst returns the value of the first environment variable among keys that
+// is set and non-empty. It returns an error listing keys if none of them is set.
+func TryGetEnvFirst(keys ...string) (string, error) {
+	_, v, err := TryGetEnvFirstKey(keys...)
+	return v, err
+}
+
+// TryGetEnvFirstKey is like TryGetEnvFirst but also returns the key that matched, so
+// callers can, for example, warn when a deprecated key is still in use.
+func TryGetEnvFirstKey(keys ...string) (key, value string, err error) {
+	for _, k := range keys {
+		if v, err := TryGetEnv(k); err == nil {
+			return k, v, nil
+		}
+	}
+	return "", "", notFoundAnyError(keys)
+}
+
+// MustGetEnvFirst returns the value of the first environment variable among keys that
+// is set and non-empty. It panics if none of them is set.
+func MustGetEnvFirst(keys ...string) string {
+	v, err := TryGetEnvFirst(keys...)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
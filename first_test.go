@@ -0,0 +1,73 @@
+package goenv_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/battlej07/goenv"
+)
+
+/* ---------- first of keys ---------- */
+
+func TestTryGetEnvFirstKey(t *testing.T) {
+	tests := []struct {
+		name    string
+		env     map[string]string
+		wantKey string
+		want    string
+		wantErr bool
+	}{
+		{name: "first key hit", env: map[string]string{"NEW_KEY": "new", "OLD_KEY": "old"}, wantKey: "NEW_KEY", want: "new"},
+		{name: "second key hit", env: map[string]string{"OLD_KEY": "old"}, wantKey: "OLD_KEY", want: "old"},
+		{name: "empty first key skipped", env: map[string]string{"NEW_KEY": "", "OLD_KEY": "old"}, wantKey: "OLD_KEY", want: "old"},
+		{name: "none present", env: map[string]string{}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			unsetForTest(t, "NEW_KEY", "OLD_KEY")
+			for k, v := range tt.env {
+				t.Setenv(k, v)
+			}
+			key, got, err := goenv.TryGetEnvFirstKey("NEW_KEY", "OLD_KEY")
+			if tt.wantErr {
+				if !errors.Is(err, goenv.ErrNotFound) || !strings.Contains(err.Error(), "NEW_KEY, OLD_KEY") {
+					t.Fatalf("TryGetEnvFirstKey() error = %v, want ErrNotFound naming both keys", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("TryGetEnvFirstKey() failed: %v", err)
+			}
+			if key != tt.wantKey || got != tt.want {
+				t.Errorf("TryGetEnvFirstKey() = %q, %q, want %q, %q", key, got, tt.wantKey, tt.want)
+			}
+			if v, err := goenv.TryGetEnvFirst("NEW_KEY", "OLD_KEY"); err != nil || v != tt.want {
+				t.Errorf("TryGetEnvFirst() = %q, %v, want %q", v, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetEnvFirst(t *testing.T) {
+	unsetForTest(t, "NEW_KEY", "OLD_KEY")
+	if got := goenv.GetEnvFirst("default", "NEW_KEY", "OLD_KEY"); got != "default" {
+		t.Errorf("GetEnvFirst() = %q, want default", got)
+	}
+	if got := goenv.GetEnvFirst("default"); got != "default" {
+		t.Errorf("GetEnvFirst() with no keys = %q, want default", got)
+	}
+	func() {
+		defer expectPanic(t, true)()
+		goenv.MustGetEnvFirst("NEW_KEY", "OLD_KEY")
+	}()
+
+	t.Setenv("OLD_KEY", "old")
+	if got := goenv.GetEnvFirst("default", "NEW_KEY", "OLD_KEY"); got != "old" {
+		t.Errorf("GetEnvFirst() = %q, want old", got)
+	}
+	func() {
+		defer expectPanic(t, false)()
+		goenv.MustGetEnvFirst("NEW_KEY", "OLD_KEY")
+	}()
+}
@@ -0,0 +1,47 @@
+package goenv
+
+import "time"
+
+// GetEnvFunc returns the value of the environment variable named by key. If the
+// variable is unset or empty, it returns the result of calling fallback, which is not
+// called otherwise, so an expensive default such as a file read is only computed when
+// needed.
+func GetEnvFunc(key string, fallback func() string) string {
+	v, err := TryGetEnv(key)
+	return orFallbackFunc(key, v, err, fallback)
+}
+
+// GetEnvIntFunc is like GetEnvInt but calls fallback for the default only when the
+// variable is unset, empty, or cannot be parsed.
+func GetEnvIntFunc(key string, fallback func() int) int {
+	v, err := TryGetEnvInt(key)
+	return orFallbackFunc(key, v, err, fallback)
+}
+
+// GetEnvFloat64Func is like GetEnvFloat64 but calls fallback for the default only when
+// the variable is unset, empty, or cannot be parsed.
+func GetEnvFloat64Func(key string, fallback func() float64) float64 {
+	v, err := TryGetEnvFloat64(key)
+	return orFallbackFunc(key, v, err, fallback)
+}
+
+// GetEnvBoolFunc is like GetEnvBool but calls fallback for the default only when the
+// variable is unset, empty, or cannot be parsed.
+func GetEnvBoolFunc(key string, fallback func() bool) bool {
+	v, err := TryGetEnvBool(key)
+	return orFallbackFunc(key, v, err, fallback)
+}
+
+// GetEnvDurationFunc is like GetEnvDuration but calls fallback for the default only
+// when the variable is unset, empty, or cannot be parsed.
+func GetEnvDurationFunc(key string, fallback func() time.Duration) time.Duration {
+	v, err := TryGetEnvDuration(key)
+	return orFallbackFunc(key, v, err, fallback)
+}
+
+// GetEnvAsFunc is the generic form of the Func getters: it converts the value with
+// parse and calls fallback only when the variable is unset, empty, or parse fails.
+func GetEnvAsFunc[T any](key string, fallback func() T, parse func(string) (T, error)) T {
+	v, err := TryGetEnvAs(key, parse)
+	return orFallbackFunc(key, v, err, fallback)
+}
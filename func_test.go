@@ -0,0 +1,85 @@
+package goenv_test
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/battlej07/goenv"
+)
+
+/* ---------- lazy fallbacks ---------- */
+
+// counter returns a fallback func yielding v and a pointer to its call count.
+func counter[T any](v T) (func() T, *int) {
+	n := new(int)
+	return func() T { *n++; return v }, n
+}
+
+func TestGetEnvFunc(t *testing.T) {
+	fallback, calls := counter("computed")
+
+	t.Setenv("ENV_FUNC", "set")
+	if got := goenv.GetEnvFunc("ENV_FUNC", fallback); got != "set" {
+		t.Errorf("GetEnvFunc() = %q, want set", got)
+	}
+	if *calls != 0 {
+		t.Errorf("fallback called %d times while the variable was set", *calls)
+	}
+
+	t.Setenv("ENV_FUNC", "")
+	if got := goenv.GetEnvFunc("ENV_FUNC", fallback); got != "computed" {
+		t.Errorf("GetEnvFunc() = %q, want computed", got)
+	}
+	if *calls != 1 {
+		t.Errorf("fallback called %d times, want 1", *calls)
+	}
+}
+
+func TestGetEnvTypedFunc(t *testing.T) {
+	intFallback, intCalls := counter(7)
+	floatFallback, floatCalls := counter(0.5)
+	boolFallback, boolCalls := counter(true)
+	durFallback, durCalls := counter(time.Second)
+	asFallback, asCalls := counter(int64(9))
+	parseInt64 := func(s string) (int64, error) { return strconv.ParseInt(s, 10, 64) }
+
+	t.Setenv("ENV_FUNC", "0")
+	if got := goenv.GetEnvIntFunc("ENV_FUNC", intFallback); got != 0 {
+		t.Errorf("GetEnvIntFunc() = %d, want 0", got)
+	}
+	if got := goenv.GetEnvFloat64Func("ENV_FUNC", floatFallback); got != 0 {
+		t.Errorf("GetEnvFloat64Func() = %v, want 0", got)
+	}
+	if got := goenv.GetEnvBoolFunc("ENV_FUNC", boolFallback); got {
+		t.Error("GetEnvBoolFunc() = true, want false")
+	}
+	if got := goenv.GetEnvDurationFunc("ENV_FUNC", durFallback); got != 0 {
+		t.Errorf("GetEnvDurationFunc() = %v, want 0", got)
+	}
+	if got := goenv.GetEnvAsFunc("ENV_FUNC", asFallback, parseInt64); got != 0 {
+		t.Errorf("GetEnvAsFunc() = %d, want 0", got)
+	}
+	for name, n := range map[string]int{"int": *intCalls, "float": *floatCalls, "bool": *boolCalls, "duration": *durCalls, "as": *asCalls} {
+		if n != 0 {
+			t.Errorf("%s fallback called %d times while the variable was set", name, n)
+		}
+	}
+
+	t.Setenv("ENV_FUNC", "garbage")
+	if got := goenv.GetEnvIntFunc("ENV_FUNC", intFallback); got != 7 || *intCalls != 1 {
+		t.Errorf("GetEnvIntFunc() = %d after %d calls, want 7 after 1", got, *intCalls)
+	}
+	if got := goenv.GetEnvFloat64Func("ENV_FUNC", floatFallback); got != 0.5 || *floatCalls != 1 {
+		t.Errorf("GetEnvFloat64Func() = %v after %d calls, want 0.5 after 1", got, *floatCalls)
+	}
+	if got := goenv.GetEnvBoolFunc("ENV_FUNC", boolFallback); !got || *boolCalls != 1 {
+		t.Errorf("GetEnvBoolFunc() = %v after %d calls, want true after 1", got, *boolCalls)
+	}
+	if got := goenv.GetEnvDurationFunc("ENV_FUNC", durFallback); got != time.Second || *durCalls != 1 {
+		t.Errorf("GetEnvDurationFunc() = %v after %d calls, want 1s after 1", got, *durCalls)
+	}
+	if got := goenv.GetEnvAsFunc("ENV_FUNC", asFallback, parseInt64); got != 9 || *asCalls != 1 {
+		t.Errorf("GetEnvAsFunc() = %d after %d calls, want 9 after 1", got, *asCalls)
+	}
+}
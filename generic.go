@@ -0,0 +1,37 @@
+package goenv
+
+// GetEnvAs returns the value of the environment variable named by key converted with
+// parse. If the variable is unset, empty, or parse fails, it returns fallback.
+// It is the generic form of the typed getters: GetEnvInt, for example, behaves like
+// GetEnvAs(key, fallback, strconv.Atoi), so any type with a parse function can be read
+// without a dedicated helper.
+func GetEnvAs[T any](key string, fallback T, parse func(string) (T, error)) T {
+	v, err := TryGetEnvAs(key, parse)
+	return orFallback(key, v, err, fallback)
+}
+
+// TryGetEnvAs returns the value of the environment variable named by key converted with
+// parse. It returns an error if the variable is unset or empty, in which case parse is
+// not called, or the error from parse wrapped with the key name.
+func TryGetEnvAs[T any](key string, parse func(string) (T, error)) (T, error) {
+	var zero T
+	v, err := tryGetEnv(key, typeName[T]())
+	if err != nil {
+		return zero, err
+	}
+	t, err := parse(v)
+	if err != nil {
+		return zero, parseErrorf(key, "env variable with key %s: %w", key, err)
+	}
+	return t, nil
+}
+
+// MustGetEnvAs returns the value of the environment variable named by key converted
+// with parse. It panics if the variable is unset, empty, or parse fails.
+func MustGetEnvAs[T any](key string, parse func(string) (T, error)) T {
+	v, err := TryGetEnvAs(key, parse)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
@@ -0,0 +1,131 @@
+package goenv_test
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"testing"
+
+	"github.com/battlej07/goenv"
+)
+
+/* ---------- GetEnvAs ---------- */
+
+type color int
+
+const (
+	red color = iota + 1
+	green
+	blue
+)
+
+var errUnknownColor = errors.New("unknown color")
+
+func parseColor(s string) (color, error) {
+	switch s {
+	case "red":
+		return red, nil
+	case "green":
+		return green, nil
+	case "blue":
+		return blue, nil
+	}
+	return 0, fmt.Errorf("%w %q", errUnknownColor, s)
+}
+
+func parseIP(s string) (net.IP, error) {
+	ip := net.ParseIP(s)
+	if ip == nil {
+		return nil, fmt.Errorf("invalid IP address %q", s)
+	}
+	return ip, nil
+}
+
+func TestGetEnvAs(t *testing.T) {
+	tests := []struct {
+		name     string
+		set      bool
+		value    string
+		fallback color
+		want     color
+	}{
+		{name: "valid", set: true, value: "green", fallback: red, want: green},
+		{name: "invalid -> fallback", set: true, value: "pink", fallback: red, want: red},
+		{name: "empty -> fallback", set: true, value: "", fallback: blue, want: blue},
+		{name: "missing -> fallback", set: false, fallback: blue, want: blue},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.set {
+				t.Setenv("ENV_AS_COLOR", tt.value)
+			}
+			if got := goenv.GetEnvAs("ENV_AS_COLOR", tt.fallback, parseColor); got != tt.want {
+				t.Errorf("GetEnvAs() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTryGetEnvAs(t *testing.T) {
+	t.Run("custom type", func(t *testing.T) {
+		t.Setenv("ENV_AS_COLOR", "blue")
+		got, err := goenv.TryGetEnvAs("ENV_AS_COLOR", parseColor)
+		if err != nil || got != blue {
+			t.Errorf("TryGetEnvAs() = %v, %v, want %v", got, err, blue)
+		}
+	})
+
+	t.Run("net.IP", func(t *testing.T) {
+		t.Setenv("ENV_AS_IP", "192.168.1.10")
+		got, err := goenv.TryGetEnvAs("ENV_AS_IP", parseIP)
+		if err != nil || !got.Equal(net.IPv4(192, 168, 1, 10)) {
+			t.Errorf("TryGetEnvAs() = %v, %v, want 192.168.1.10", got, err)
+		}
+	})
+
+	t.Run("parse error wrapped with key", func(t *testing.T) {
+		t.Setenv("ENV_AS_COLOR", "pink")
+		_, err := goenv.TryGetEnvAs("ENV_AS_COLOR", parseColor)
+		if !errors.Is(err, errUnknownColor) {
+			t.Fatalf("TryGetEnvAs() error = %v, want it to wrap errUnknownColor", err)
+		}
+		if want := `env variable with key ENV_AS_COLOR: unknown color "pink"`; err.Error() != want {
+			t.Errorf("TryGetEnvAs() error = %q, want %q", err, want)
+		}
+	})
+
+	t.Run("missing skips parse", func(t *testing.T) {
+		called := false
+		_, err := goenv.TryGetEnvAs("ENV_AS_MISSING", func(s string) (int, error) {
+			called = true
+			return 0, nil
+		})
+		if err == nil {
+			t.Error("TryGetEnvAs() succeeded unexpectedly")
+		}
+		if called {
+			t.Error("TryGetEnvAs() called parse for a missing variable")
+		}
+	})
+}
+
+func TestMustGetEnvAs(t *testing.T) {
+	tests := []struct {
+		name      string
+		value     string
+		wantPanic bool
+	}{
+		{name: "valid", value: "::1"},
+		{name: "invalid -> panic", value: "not-an-ip", wantPanic: true},
+		{name: "empty -> panic", value: "", wantPanic: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("ENV_AS_IP", tt.value)
+			defer expectPanic(t, tt.wantPanic)()
+			if got := goenv.MustGetEnvAs("ENV_AS_IP", parseIP); !got.Equal(net.IPv6loopback) {
+				t.Errorf("MustGetEnvAs() = %v, want ::1", got)
+			}
+		})
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"os"
 	"reflect"
 	"strconv"
+	"strings"
 	"time"
 )
 
@@ -15,50 +16,43 @@ import (
 // If the variable is unset or empty, it returns fallback.
 func GetEnv(key, fallback string) string {
 	v, err := TryGetEnv(key)
-	if err != nil {
-		return fallback
-	}
-	return v
+	return orFallback(key, v, err, fallback)
 }
 
 // GetEnvInt returns the integer value of the environment variable named by key.
 // If the variable is unset, empty, or cannot be parsed, it returns fallback.
 func GetEnvInt(key string, fallback int) int {
 	v, err := TryGetEnvInt(key)
-	if err != nil {
-		return fallback
-	}
-	return v
+	return orFallback(key, v, err, fallback)
 }
 
 // GetEnvFloat32 returns the float32 value of the environment variable named by key.
 // If the variable is unset, empty, or cannot be parsed, it returns fallback.
 func GetEnvFloat32(key string, fallback float32) float32 {
 	v, err := TryGetEnvFloat32(key)
-	if err != nil {
-		return fallback
-	}
-	return v
+	return orFallback(key, v, err, fallback)
 }
 
 // GetEnvFloat64 returns the float64 value of the environment variable named by key.
 // If the variable is unset, empty, or cannot be parsed, it returns fallback.
 func GetEnvFloat64(key string, fallback float64) float64 {
 	v, err := TryGetEnvFloat64(key)
-	if err != nil {
-		return fallback
-	}
-	return v
+	return orFallback(key, v, err, fallback)
 }
 
 // GetEnvBool returns the boolean value of the environment variable named by key.
 // If the variable is unset, empty, or cannot be parsed, it returns fallback.
 func GetEnvBool(key string, fallback bool) bool {
 	v, err := TryGetEnvBool(key)
-	if err != nil {
-		return fallback
-	}
-	return v
+	return orFallback(key, v, err, fallback)
+}
+
+// GetEnvBoolExtended returns the boolean value of the environment variable named by
+// key, accepting shell-style spellings such as "yes" and "off" as well as those of
+// GetEnvBool. If the variable is unset, empty, or unrecognized, it returns fallback.
+func GetEnvBoolExtended(key string, fallback bool) bool {
+	v, err := TryGetEnvBoolExtended(key)
+	return orFallback(key, v, err, fallback)
 }
 
 // GetEnvTime returns the time value of the environment variable named by key.
@@ -66,10 +60,15 @@ func GetEnvBool(key string, fallback bool) bool {
 // cannot be parsed, it returns fallback.
 func GetEnvTime(key string, fallback time.Time) time.Time {
 	v, err := TryGetEnvTime(key)
-	if err != nil {
-		return fallback
-	}
-	return v
+	return orFallback(key, v, err, fallback)
+}
+
+// GetEnvTimeLayout returns the time value of the environment variable named by key,
+// parsed with time.Parse and layout, e.g. "2006-01-02" for dates. If the variable is
+// unset, empty, or cannot be parsed, it returns fallback.
+func GetEnvTimeLayout(key, layout string, fallback time.Time) time.Time {
+	v, err := TryGetEnvTimeLayout(key, layout)
+	return orFallback(key, v, err, fallback)
 }
 
 // GetEnvDuration returns the duration value of the environment variable named by key.
@@ -77,99 +76,131 @@ func GetEnvTime(key string, fallback time.Time) time.Time {
 // or cannot be parsed, it returns fallback.
 func GetEnvDuration(key string, fallback time.Duration) time.Duration {
 	v, err := TryGetEnvDuration(key)
-	if err != nil {
-		return fallback
+	return orFallback(key, v, err, fallback)
+}
+
+// GetEnvFlagPresent reports whether the environment variable named by key is set,
+// regardless of its value. Unlike GetEnvBool, an empty value or even "false" counts
+// as present, which matches presence-style flags such as DEBUG=.
+func GetEnvFlagPresent(key string) bool {
+	_, ok := os.LookupEnv(key)
+	if trackingEnabled.Load() {
+		appendAccess(Access{Key: key, Type: "bool", Found: ok})
 	}
-	return v
+	return ok
 }
 
 // TryGetEnv returns the value of the environment variable named by key.
 // It returns an error if the variable is unset or empty.
 func TryGetEnv(key string) (string, error) {
-	if v := os.Getenv(key); v != "" {
+	return tryGetEnv(key, "string")
+}
+
+// tryGetEnv is TryGetEnv recording the read as a lookup of typ.
+func tryGetEnv(key, typ string) (string, error) {
+	if v := lookupEnv(key, typ); v != "" {
 		return v, nil
 	}
-	return "", fmt.Errorf("unable to find env variable with key %s", key)
+	return "", notFoundError(key)
 }
 
 // TryGetEnvInt returns the integer value of the environment variable named by key.
 // It returns an error if the variable is unset, empty, or cannot be parsed as int.
+// It is TryGetEnvIntBase with base 10.
 func TryGetEnvInt(key string) (int, error) {
-	if v := os.Getenv(key); v != "" {
-		i, err := strconv.Atoi(v)
-		if err != nil {
-			return 0, fmt.Errorf("unable to convert %s to an integer", v)
-		}
-		return i, nil
-	}
-	return 0, fmt.Errorf("unable to find env variable with key %s", key)
+	return TryGetEnvIntBase(key, 10)
 }
 
 // TryGetEnvFloat32 returns the float32 value of the environment variable named by key.
 // It returns an error if the variable is unset, empty, or cannot be parsed as float32.
 func TryGetEnvFloat32(key string) (float32, error) {
-	if v := os.Getenv(key); v != "" {
+	if v := lookupEnv(key, "float32"); v != "" {
 		f, err := strconv.ParseFloat(v, 32)
 		if err != nil {
-			return 0, fmt.Errorf("unable to convert %q to float32: %w", v, err)
+			return 0, parseErrorf(key, "unable to convert %q to float32: %w", v, err)
 		}
 		return float32(f), nil
 	}
-	return 0, fmt.Errorf("unable to find env variable with key %s", key)
+	return 0, notFoundError(key)
 }
 
 // TryGetEnvFloat64 returns the float64 value of the environment variable named by key.
 // It returns an error if the variable is unset, empty, or cannot be parsed as float64.
 func TryGetEnvFloat64(key string) (float64, error) {
-	if v := os.Getenv(key); v != "" {
+	if v := lookupEnv(key, "float64"); v != "" {
 		f, err := strconv.ParseFloat(v, 64)
 		if err != nil {
-			return 0, fmt.Errorf("unable to convert %q to float64: %w", v, err)
+			return 0, parseErrorf(key, "unable to convert %q to float64: %w", v, err)
 		}
 		return f, nil
 	}
-	return 0, fmt.Errorf("unable to find env variable with key %s", key)
+	return 0, notFoundError(key)
 }
 
 // TryGetEnvBool returns the boolean value of the environment variable named by key.
 // It returns an error if the variable is unset, empty, or cannot be parsed as bool.
 func TryGetEnvBool(key string) (bool, error) {
-	if v := os.Getenv(key); v != "" {
+	if v := lookupEnv(key, "bool"); v != "" {
 		b, err := strconv.ParseBool(v)
 		if err != nil {
-			return false, fmt.Errorf("unable to convert %q to bool: %w", v, err)
+			return false, parseErrorf(key, "unable to convert %q to bool: %w", v, err)
 		}
 		return b, nil
 	}
-	return false, fmt.Errorf("unable to find env variable with key %s", key)
+	return false, notFoundError(key)
+}
+
+// TryGetEnvBoolExtended returns the boolean value of the environment variable named by
+// key. It accepts, case-insensitively, "1", "t", "true", "yes", "y" and "on" as true and
+// "0", "f", "false", "no", "n" and "off" as false. It returns an error if the variable
+// is unset, empty, or any other value.
+func TryGetEnvBoolExtended(key string) (bool, error) {
+	v, err := tryGetEnv(key, "bool")
+	if err != nil {
+		return false, err
+	}
+	switch strings.ToLower(v) {
+	case "1", "t", "true", "yes", "y", "on":
+		return true, nil
+	case "0", "f", "false", "no", "n", "off":
+		return false, nil
+	}
+	return false, parseErrorf(key, "unable to convert %q to bool", v)
 }
 
 // TryGetEnvTime returns the time value of the environment variable named by key.
 // The value must be in RFC3339 format. It returns an error if the variable is unset,
 // empty, or cannot be parsed.
 func TryGetEnvTime(key string) (time.Time, error) {
-	if v := os.Getenv(key); v != "" {
-		t, err := time.Parse(time.RFC3339, v)
+	return TryGetEnvTimeLayout(key, time.RFC3339)
+}
+
+// TryGetEnvTimeLayout returns the time value of the environment variable named by key,
+// parsed with time.Parse and layout. It returns an error naming the value and the
+// layout if the variable cannot be parsed, or if it is unset or empty.
+func TryGetEnvTimeLayout(key, layout string) (time.Time, error) {
+	if v := lookupEnv(key, "time.Time"); v != "" {
+		t, err := time.Parse(layout, v)
 		if err != nil {
-			return time.Time{}, fmt.Errorf("unable to parse %q as time (RFC3339): %w", v, err)
+			return time.Time{}, parseErrorf(key, "unable to parse %q as time with layout %q: %w", v, layout, err)
 		}
 		return t, nil
 	}
-	return time.Time{}, fmt.Errorf("unable to find env variable with key %s", key)
+	return time.Time{}, notFoundError(key)
 }
 
 // TryGetEnvDuration returns the duration value of the environment variable named by key.
 // The value must be a valid time.ParseDuration string. It returns an error if the variable
 // is unset, empty, or cannot be parsed.
 func TryGetEnvDuration(key string) (time.Duration, error) {
-	if v := os.Getenv(key); v != "" {
+	if v := lookupEnv(key, "time.Duration"); v != "" {
 		d, err := time.ParseDuration(v)
 		if err != nil {
-			return 0, fmt.Errorf("unable to parse %q as duration: %w", v, err)
+			return 0, parseErrorf(key, "unable to parse %q as duration: %w", v, err)
 		}
 		return d, nil
 	}
-	return 0, fmt.Errorf("unable to find env variable with key %s", key)
+	return 0, notFoundError(key)
 }
 
 // MustGetEnv returns the value of the environment variable named by key.
@@ -222,6 +253,17 @@ func MustGetEnvBool(key string) bool {
 	return v
 }
 
+// MustGetEnvBoolExtended returns the boolean value of the environment variable named
+// by key, accepting the spellings of TryGetEnvBoolExtended. It panics if the variable
+// is unset, empty, or unrecognized.
+func MustGetEnvBoolExtended(key string) bool {
+	v, err := TryGetEnvBoolExtended(key)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
 // MustGetEnvTime returns the time value of the environment variable named by key.
 // The value must be in RFC3339 format. It panics if the variable is unset, empty,
 // or cannot be parsed.
@@ -233,6 +275,17 @@ func MustGetEnvTime(key string) time.Time {
 	return v
 }
 
+// MustGetEnvTimeLayout returns the time value of the environment variable named by key,
+// parsed with time.Parse and layout. It panics if the variable is unset, empty, or
+// cannot be parsed.
+func MustGetEnvTimeLayout(key, layout string) time.Time {
+	v, err := TryGetEnvTimeLayout(key, layout)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
 // MustGetEnvDuration returns the duration value of the environment variable named by key.
 // The value must be a valid time.ParseDuration string. It panics if the variable is unset,
 // empty, or cannot be parsed.
@@ -393,6 +446,20 @@ func setField(field reflect.Value, envKey, fallback string) error {
 			return fmt.Errorf("unsupported struct type %s", field.Type())
 		}
 
+	case reflect.Slice:
+		if field.Type().Elem().Kind() != reflect.String {
+			return fmt.Errorf("unsupported slice type %s", field.Type())
+		}
+		v, err := TryGetEnvStringSlice(envKey)
+		if err != nil {
+			if fallback != "" {
+				field.Set(reflect.ValueOf(splitCommas(fallback)).Convert(field.Type()))
+				return nil
+			}
+			return err
+		}
+		field.Set(reflect.ValueOf(v).Convert(field.Type()))
+
 	default:
 		return fmt.Errorf("unsupported field type %s", field.Kind())
 	}
@@ -2,6 +2,7 @@ package goenv_test
 
 import (
 	"math"
+	"strings"
 	"testing"
 	"time"
 
@@ -477,6 +478,85 @@ func TestMustGetEnvBool(t *testing.T) {
 	}
 }
 
+func TestGetEnvFlagPresent(t *testing.T) {
+	tests := []struct {
+		name  string
+		key   string
+		set   bool
+		value string
+		want  bool
+	}{
+		{name: "set empty -> true", key: "ENV_FLAG", set: true, value: "", want: true},
+		{name: "set false -> true", key: "ENV_FLAG", set: true, value: "false", want: true},
+		{name: "set true -> true", key: "ENV_FLAG", set: true, value: "true", want: true},
+		{name: "unset -> false", key: "ENV_FLAG", set: false, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.set {
+				t.Setenv(tt.key, tt.value)
+			}
+			if got := goenv.GetEnvFlagPresent(tt.key); got != tt.want {
+				t.Errorf("GetEnvFlagPresent() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTryGetEnvBoolExtended(t *testing.T) {
+	for _, v := range []string{"1", "t", "true", "yes", "y", "on", "T", "TRUE", "Yes", "Y", "ON"} {
+		t.Run(v, func(t *testing.T) {
+			t.Setenv("TRY_BOOL_EXT", v)
+			if got, err := goenv.TryGetEnvBoolExtended("TRY_BOOL_EXT"); err != nil || !got {
+				t.Errorf("TryGetEnvBoolExtended() = %v, %v, want true", got, err)
+			}
+		})
+	}
+	for _, v := range []string{"0", "f", "false", "no", "n", "off", "F", "False", "NO", "N", "Off"} {
+		t.Run(v, func(t *testing.T) {
+			t.Setenv("TRY_BOOL_EXT", v)
+			if got, err := goenv.TryGetEnvBoolExtended("TRY_BOOL_EXT"); err != nil || got {
+				t.Errorf("TryGetEnvBoolExtended() = %v, %v, want false", got, err)
+			}
+		})
+	}
+	for _, v := range []string{"maybe", "enabled", "2", " yes", ""} {
+		t.Run("bad "+v, func(t *testing.T) {
+			t.Setenv("TRY_BOOL_EXT", v)
+			if _, err := goenv.TryGetEnvBoolExtended("TRY_BOOL_EXT"); err == nil {
+				t.Errorf("TryGetEnvBoolExtended(%q) succeeded unexpectedly", v)
+			}
+		})
+	}
+}
+
+func TestGetEnvBoolExtended(t *testing.T) {
+	t.Setenv("GET_BOOL_EXT", "on")
+	if got := goenv.GetEnvBoolExtended("GET_BOOL_EXT", false); !got {
+		t.Error("GetEnvBoolExtended() = false, want true")
+	}
+	if _, err := goenv.TryGetEnvBool("GET_BOOL_EXT"); err == nil {
+		t.Error("TryGetEnvBool() accepted \"on\", want strict parsing unchanged")
+	}
+
+	t.Setenv("GET_BOOL_EXT", "maybe")
+	if got := goenv.GetEnvBoolExtended("GET_BOOL_EXT", true); !got {
+		t.Error("GetEnvBoolExtended() = false, want fallback true")
+	}
+	func() {
+		defer expectPanic(t, true)()
+		goenv.MustGetEnvBoolExtended("GET_BOOL_EXT")
+	}()
+
+	t.Setenv("GET_BOOL_EXT", "no")
+	func() {
+		defer expectPanic(t, false)()
+		if goenv.MustGetEnvBoolExtended("GET_BOOL_EXT") {
+			t.Error("MustGetEnvBoolExtended() = true, want false")
+		}
+	}()
+}
+
 /* ---------- time.Time (RFC3339) ---------- */
 
 func TestGetEnvTime(t *testing.T) {
@@ -575,6 +655,64 @@ func TestMustGetEnvTime(t *testing.T) {
 	}
 }
 
+func TestGetEnvTimeLayout(t *testing.T) {
+	fallback := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name   string
+		set    bool
+		value  string
+		layout string
+		want   time.Time
+	}{
+		{name: "date only", set: true, value: "2025-01-02", layout: time.DateOnly, want: time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)},
+		{name: "US style", set: true, value: "01/02/2006 15:04", layout: "01/02/2006 15:04", want: time.Date(2006, 1, 2, 15, 4, 0, 0, time.UTC)},
+		{name: "wrong layout -> fallback", set: true, value: "2025-01-02", layout: "01/02/2006 15:04", want: fallback},
+		{name: "missing -> fallback", set: false, layout: time.DateOnly, want: fallback},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.set {
+				t.Setenv("TEST_TIME_LAYOUT", tt.value)
+			}
+			got := goenv.GetEnvTimeLayout("TEST_TIME_LAYOUT", tt.layout, fallback)
+			if !got.Equal(tt.want) {
+				t.Errorf("GetEnvTimeLayout() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTryGetEnvTimeLayout(t *testing.T) {
+	t.Setenv("TEST_TIME_LAYOUT", "2025-13-02")
+	_, err := goenv.TryGetEnvTimeLayout("TEST_TIME_LAYOUT", time.DateOnly)
+	if err == nil {
+		t.Fatal("TryGetEnvTimeLayout() succeeded unexpectedly")
+	}
+	if msg := err.Error(); !strings.Contains(msg, `"2025-13-02"`) || !strings.Contains(msg, `"2006-01-02"`) {
+		t.Errorf("TryGetEnvTimeLayout() error = %q, want it to name the value and the layout", msg)
+	}
+
+	t.Setenv("TEST_TIME_LAYOUT", "03/04/2025 05:06")
+	got, err := goenv.TryGetEnvTimeLayout("TEST_TIME_LAYOUT", "01/02/2006 15:04")
+	if want := time.Date(2025, 3, 4, 5, 6, 0, 0, time.UTC); err != nil || !got.Equal(want) {
+		t.Errorf("TryGetEnvTimeLayout() = %v, %v, want %v", got, err, want)
+	}
+}
+
+func TestMustGetEnvTimeLayout(t *testing.T) {
+	t.Setenv("TEST_TIME_LAYOUT", "2025-01-02")
+	func() {
+		defer expectPanic(t, false)()
+		if got := goenv.MustGetEnvTimeLayout("TEST_TIME_LAYOUT", time.DateOnly); got.Year() != 2025 {
+			t.Errorf("MustGetEnvTimeLayout() = %v, want 2025-01-02", got)
+		}
+	}()
+	func() {
+		defer expectPanic(t, true)()
+		goenv.MustGetEnvTimeLayout("TEST_TIME_LAYOUT", time.Kitchen)
+	}()
+}
+
 /* ---------- time.Duration ---------- */
 
 func TestGetEnvDuration(t *testing.T) {
@@ -869,3 +1007,25 @@ func TestLoad(t *testing.T) {
 	})
 }
 
+func TestLoadStringSlice(t *testing.T) {
+	type Config struct {
+		Origins []string `goenv:"APP_ORIGINS"`
+		Tags    []string `goenv:"APP_TAGS" fallback:"a, b"`
+		Ports   []int    `goenv:"APP_PORTS"`
+	}
+
+	t.Setenv("APP_ORIGINS", "x.com, y.com,")
+	t.Setenv("APP_PORTS", "1,2")
+
+	var cfg Config
+	err := goenv.Load(&cfg)
+	if err == nil {
+		t.Fatal("Load() should have failed with unsupported []int field")
+	}
+	if len(cfg.Origins) != 2 || cfg.Origins[0] != "x.com" || cfg.Origins[1] != "y.com" {
+		t.Errorf("Origins = %q, want [x.com y.com]", cfg.Origins)
+	}
+	if len(cfg.Tags) != 2 || cfg.Tags[0] != "a" || cfg.Tags[1] != "b" {
+		t.Errorf("Tags = %q, want [a b]", cfg.Tags)
+	}
+}
@@ -0,0 +1,63 @@
+package goenv
+
+import "strconv"
+
+// TryGetEnvIndexedSliceTyped reads the environment variables prefix0, prefix1, ... up to
+// the first one that is unset or empty, parsing each value with parse. For example,
+// with prefix "PORT" and PORT0=80, PORT1=443 and no PORT2, it returns [80, 443].
+// It returns an error naming the index of the first value parse rejects, or if
+// prefix0 itself is unset or empty.
+func TryGetEnvIndexedSliceTyped[T any](prefix string, parse func(string) (T, error)) ([]T, error) {
+	var out []T
+	for i := 0; ; i++ {
+		key := prefix + strconv.Itoa(i)
+		v, err := tryGetEnv(key, typeName[T]())
+		if err != nil {
+			if i == 0 {
+				return nil, err
+			}
+			return out, nil
+		}
+		t, err := parse(v)
+		if err != nil {
+			return nil, parseErrorf(key, "element %d %q: %w", i, v, err)
+		}
+		out = append(out, t)
+	}
+}
+
+// GetEnvStringSliceOrIndexed returns the comma-separated elements of the environment
+// variable named by csvKey. If csvKey is unset, empty, or contains no elements, it reads
+// the contiguous indexed variables indexPrefix0, indexPrefix1, ... instead, e.g. HOST_0
+// and HOST_1 for the prefix "HOST_". If neither source is set, it returns fallback.
+func GetEnvStringSliceOrIndexed(csvKey, indexPrefix string, fallback []string) []string {
+	if v, err := TryGetEnvStringSlice(csvKey); err == nil {
+		recordHit()
+		return v
+	}
+	v, err := TryGetEnvIndexedSliceTyped(indexPrefix, func(s string) (string, error) { return s, nil })
+	return orFallback(csvKey, v, err, fallback)
+}
+
+// GetEnvLastIndexed returns the value of the highest contiguous indexed variable
+// prefix0, prefix1, ..., ignoring the earlier ones, e.g. with prefix "FLAG__" and
+// FLAG__0=a, FLAG__1=b and no FLAG__2, it returns "b". Indexing stops at the first
+// unset or empty variable. If prefix0 is unset or empty, it returns fallback.
+func GetEnvLastIndexed(prefix string, fallback string) string {
+	lastKey := prefix + "0"
+	last, err := TryGetEnv(lastKey)
+	if err != nil {
+		recordFallback(lastKey)
+		return fallback
+	}
+	for i := 1; ; i++ {
+		key := prefix + strconv.Itoa(i)
+		v, err := TryGetEnv(key)
+		if err != nil {
+			break
+		}
+		lastKey, last = key, v
+	}
+	recordHit()
+	return last
+}
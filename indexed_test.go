@@ -0,0 +1,91 @@
+package goenv_test
+
+import (
+	"slices"
+	"strconv"
+	"testing"
+
+	"github.com/battlej07/goenv"
+)
+
+/* ---------- indexed typed slice ---------- */
+
+func TestTryGetEnvIndexedSliceTyped(t *testing.T) {
+	tests := []struct {
+		name    string
+		env     map[string]string
+		want    []int
+		wantErr bool
+	}{
+		{name: "contiguous", env: map[string]string{"PORT0": "80", "PORT1": "443"}, want: []int{80, 443}},
+		{name: "stops at gap", env: map[string]string{"PORT0": "80", "PORT1": "443", "PORT3": "8080"}, want: []int{80, 443}},
+		{name: "empty value is a gap", env: map[string]string{"PORT0": "80", "PORT1": "", "PORT2": "8080"}, want: []int{80}},
+		{name: "bad value -> err", env: map[string]string{"PORT0": "80", "PORT1": "https"}, wantErr: true},
+		{name: "none -> err", env: map[string]string{"PORT1": "80"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for k, v := range tt.env {
+				t.Setenv(k, v)
+			}
+			got, err := goenv.TryGetEnvIndexedSliceTyped("PORT", strconv.Atoi)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("TryGetEnvIndexedSliceTyped() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && !slices.Equal(got, tt.want) {
+				t.Errorf("TryGetEnvIndexedSliceTyped() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+/* ---------- string slice or indexed ---------- */
+
+func TestGetEnvStringSliceOrIndexed(t *testing.T) {
+	tests := []struct {
+		name string
+		env  map[string]string
+		want []string
+	}{
+		{name: "csv present", env: map[string]string{"HOSTS": "a,b", "HOST_0": "ignored"}, want: []string{"a", "b"}},
+		{name: "indexed fallback", env: map[string]string{"HOST_0": "c", "HOST_1": "d"}, want: []string{"c", "d"}},
+		{name: "csv without elements uses indexed", env: map[string]string{"HOSTS": ",", "HOST_0": "e"}, want: []string{"e"}},
+		{name: "neither -> fallback", env: map[string]string{"HOST_1": "gap"}, want: []string{"x"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for k, v := range tt.env {
+				t.Setenv(k, v)
+			}
+			if got := goenv.GetEnvStringSliceOrIndexed("HOSTS", "HOST_", []string{"x"}); !slices.Equal(got, tt.want) {
+				t.Errorf("GetEnvStringSliceOrIndexed() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+/* ---------- last indexed ---------- */
+
+func TestGetEnvLastIndexed(t *testing.T) {
+	tests := []struct {
+		name string
+		env  map[string]string
+		want string
+	}{
+		{name: "several", env: map[string]string{"FLAG__0": "a", "FLAG__1": "b", "FLAG__2": "c"}, want: "c"},
+		{name: "single", env: map[string]string{"FLAG__0": "only"}, want: "only"},
+		{name: "gap stops", env: map[string]string{"FLAG__0": "a", "FLAG__1": "b", "FLAG__3": "d"}, want: "b"},
+		{name: "empty stops", env: map[string]string{"FLAG__0": "a", "FLAG__1": "", "FLAG__2": "c"}, want: "a"},
+		{name: "no zero -> fallback", env: map[string]string{"FLAG__1": "b"}, want: "fb"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for k, v := range tt.env {
+				t.Setenv(k, v)
+			}
+			if got := goenv.GetEnvLastIndexed("FLAG__", "fb"); got != tt.want {
+				t.Errorf("GetEnvLastIndexed() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,189 @@
+package goenv
+
+import (
+	"errors"
+	"strconv"
+)
+
+// GetEnvIntBase returns the integer value of the environment variable named by key,
+// parsed in the given base. Base 0 detects the base from the prefix, so "0xFF",
+// "0o17" and "0b101" are accepted. If the variable is unset, empty, or cannot be
+// parsed, it returns fallback.
+func GetEnvIntBase(key string, base int, fallback int) int {
+	v, err := TryGetEnvIntBase(key, base)
+	return orFallback(key, v, err, fallback)
+}
+
+// GetEnvIntRange returns the integer value of the environment variable named by key
+// if it lies within [min, max]. If the variable is unset, empty, cannot be parsed, or
+// is out of range, it returns fallback.
+func GetEnvIntRange(key string, min, max, fallback int) int {
+	v, err := TryGetEnvIntRange(key, min, max)
+	return orFallback(key, v, err, fallback)
+}
+
+// GetEnvInt64 returns the int64 value of the environment variable named by key.
+// If the variable is unset, empty, cannot be parsed, or overflows int64, it returns fallback.
+func GetEnvInt64(key string, fallback int64) int64 {
+	v, err := TryGetEnvInt64(key)
+	return orFallback(key, v, err, fallback)
+}
+
+// GetEnvInt32 returns the int32 value of the environment variable named by key.
+// If the variable is unset, empty, cannot be parsed, or overflows int32, it returns fallback.
+func GetEnvInt32(key string, fallback int32) int32 {
+	v, err := TryGetEnvInt32(key)
+	return orFallback(key, v, err, fallback)
+}
+
+// GetEnvInt16 returns the int16 value of the environment variable named by key.
+// If the variable is unset, empty, cannot be parsed, or overflows int16, it returns fallback.
+func GetEnvInt16(key string, fallback int16) int16 {
+	v, err := TryGetEnvInt16(key)
+	return orFallback(key, v, err, fallback)
+}
+
+// GetEnvInt8 returns the int8 value of the environment variable named by key.
+// If the variable is unset, empty, cannot be parsed, or overflows int8, it returns fallback.
+func GetEnvInt8(key string, fallback int8) int8 {
+	v, err := TryGetEnvInt8(key)
+	return orFallback(key, v, err, fallback)
+}
+
+// TryGetEnvIntBase returns the integer value of the environment variable named by key,
+// parsed with strconv.ParseInt in the given base; base 0 detects the base from the
+// prefix. It returns an error if the variable is unset, empty, cannot be parsed, or
+// base is invalid.
+func TryGetEnvIntBase(key string, base int) (int, error) {
+	v, err := tryGetEnv(key, "int")
+	if err != nil {
+		return 0, err
+	}
+	i, err := strconv.ParseInt(v, base, 0)
+	if err != nil {
+		return 0, parseErrorf(key, "unable to convert %q to an integer in base %d: %w", v, base, err)
+	}
+	return int(i), nil
+}
+
+// TryGetEnvIntRange returns the integer value of the environment variable named by key
+// if it satisfies min <= v <= max, e.g. 1 to 65535 for a port. It returns an error if
+// the variable is unset, empty, cannot be parsed, or is out of range.
+func TryGetEnvIntRange(key string, min, max int) (int, error) {
+	v, err := TryGetEnvInt(key)
+	if err != nil {
+		return 0, err
+	}
+	if v < min || v > max {
+		return 0, parseErrorf(key, "value %d out of range [%d, %d]", v, min, max)
+	}
+	return v, nil
+}
+
+// TryGetEnvInt64 returns the int64 value of the environment variable named by key.
+// It returns an error if the variable is unset, empty, cannot be parsed, or overflows int64.
+func TryGetEnvInt64(key string) (int64, error) {
+	i, err := tryGetEnvIntBits(key, 64)
+	return i, err
+}
+
+// TryGetEnvInt32 returns the int32 value of the environment variable named by key.
+// It returns an error if the variable is unset, empty, cannot be parsed, or overflows int32.
+func TryGetEnvInt32(key string) (int32, error) {
+	i, err := tryGetEnvIntBits(key, 32)
+	return int32(i), err
+}
+
+// TryGetEnvInt16 returns the int16 value of the environment variable named by key.
+// It returns an error if the variable is unset, empty, cannot be parsed, or overflows int16.
+func TryGetEnvInt16(key string) (int16, error) {
+	i, err := tryGetEnvIntBits(key, 16)
+	return int16(i), err
+}
+
+// TryGetEnvInt8 returns the int8 value of the environment variable named by key.
+// It returns an error if the variable is unset, empty, cannot be parsed, or overflows int8.
+func TryGetEnvInt8(key string) (int8, error) {
+	i, err := tryGetEnvIntBits(key, 8)
+	return int8(i), err
+}
+
+// MustGetEnvIntBase returns the integer value of the environment variable named by key,
+// parsed in the given base. It panics if the variable is unset, empty, cannot be
+// parsed, or base is invalid.
+func MustGetEnvIntBase(key string, base int) int {
+	v, err := TryGetEnvIntBase(key, base)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// MustGetEnvIntRange returns the integer value of the environment variable named by
+// key if it lies within [min, max]. It panics if the variable is unset, empty, cannot
+// be parsed, or is out of range.
+func MustGetEnvIntRange(key string, min, max int) int {
+	v, err := TryGetEnvIntRange(key, min, max)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// MustGetEnvInt64 returns the int64 value of the environment variable named by key.
+// It panics if the variable is unset, empty, cannot be parsed, or overflows int64.
+func MustGetEnvInt64(key string) int64 {
+	v, err := TryGetEnvInt64(key)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// MustGetEnvInt32 returns the int32 value of the environment variable named by key.
+// It panics if the variable is unset, empty, cannot be parsed, or overflows int32.
+func MustGetEnvInt32(key string) int32 {
+	v, err := TryGetEnvInt32(key)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// MustGetEnvInt16 returns the int16 value of the environment variable named by key.
+// It panics if the variable is unset, empty, cannot be parsed, or overflows int16.
+func MustGetEnvInt16(key string) int16 {
+	v, err := TryGetEnvInt16(key)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// MustGetEnvInt8 returns the int8 value of the environment variable named by key.
+// It panics if the variable is unset, empty, cannot be parsed, or overflows int8.
+func MustGetEnvInt8(key string) int8 {
+	v, err := TryGetEnvInt8(key)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// tryGetEnvIntBits parses key's value as a base-10 integer that fits in bits bits.
+// Errors name the target type and the strconv reason, e.g. "value out of range".
+func tryGetEnvIntBits(key string, bits int) (int64, error) {
+	v, err := tryGetEnv(key, "int"+strconv.Itoa(bits))
+	if err != nil {
+		return 0, err
+	}
+	i, err := strconv.ParseInt(v, 10, bits)
+	if err != nil {
+		var numErr *strconv.NumError
+		if errors.As(err, &numErr) {
+			err = numErr.Err
+		}
+		return 0, parseErrorf(key, "unable to convert %q to int%d: %w", v, bits, err)
+	}
+	return i, nil
+}
@@ -0,0 +1,245 @@
+package goenv_test
+
+import (
+	"errors"
+	"math"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/battlej07/goenv"
+)
+
+/* ---------- sized ints ---------- */
+
+func TestTryGetEnvSizedInts(t *testing.T) {
+	try64 := func(k string) (int64, error) { return goenv.TryGetEnvInt64(k) }
+	try32 := func(k string) (int64, error) { v, err := goenv.TryGetEnvInt32(k); return int64(v), err }
+	try16 := func(k string) (int64, error) { v, err := goenv.TryGetEnvInt16(k); return int64(v), err }
+	try8 := func(k string) (int64, error) { v, err := goenv.TryGetEnvInt8(k); return int64(v), err }
+
+	tests := []struct {
+		name    string
+		try     func(string) (int64, error)
+		set     bool
+		value   string
+		want    int64
+		wantErr string
+	}{
+		{name: "int64 max", try: try64, set: true, value: strconv.FormatInt(math.MaxInt64, 10), want: math.MaxInt64},
+		{name: "int64 min", try: try64, set: true, value: strconv.FormatInt(math.MinInt64, 10), want: math.MinInt64},
+		{name: "int64 overflow", try: try64, set: true, value: "9223372036854775808", wantErr: `unable to convert "9223372036854775808" to int64: value out of range`},
+		{name: "int32 max", try: try32, set: true, value: "2147483647", want: math.MaxInt32},
+		{name: "int32 min", try: try32, set: true, value: "-2147483648", want: math.MinInt32},
+		{name: "int32 overflow", try: try32, set: true, value: "2147483648", wantErr: `unable to convert "2147483648" to int32: value out of range`},
+		{name: "int16 max", try: try16, set: true, value: "32767", want: math.MaxInt16},
+		{name: "int16 min", try: try16, set: true, value: "-32768", want: math.MinInt16},
+		{name: "int16 overflow", try: try16, set: true, value: "32768", wantErr: `unable to convert "32768" to int16: value out of range`},
+		{name: "int16 underflow", try: try16, set: true, value: "-32769", wantErr: `unable to convert "-32769" to int16: value out of range`},
+		{name: "int16 large", try: try16, set: true, value: "99999999999", wantErr: `unable to convert "99999999999" to int16: value out of range`},
+		{name: "int8 max", try: try8, set: true, value: "127", want: math.MaxInt8},
+		{name: "int8 min", try: try8, set: true, value: "-128", want: math.MinInt8},
+		{name: "int8 overflow", try: try8, set: true, value: "128", wantErr: `unable to convert "128" to int8: value out of range`},
+		{name: "invalid", try: try32, set: true, value: "12a", wantErr: `unable to convert "12a" to int32: invalid syntax`},
+		{name: "missing", try: try64, set: false, wantErr: "unable to find env variable with key ENV_SIZED_INT"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.set {
+				t.Setenv("ENV_SIZED_INT", tt.value)
+			}
+			got, err := tt.try("ENV_SIZED_INT")
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Fatalf("error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("got %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTryGetEnvSizedIntsWrapRange(t *testing.T) {
+	t.Setenv("ENV_SIZED_INT", "200")
+	if _, err := goenv.TryGetEnvInt8("ENV_SIZED_INT"); !errors.Is(err, strconv.ErrRange) {
+		t.Errorf("TryGetEnvInt8() error = %v, want strconv.ErrRange", err)
+	}
+}
+
+func TestGetEnvSizedInts(t *testing.T) {
+	t.Setenv("ENV_SIZED_INT", "32768")
+	if got := goenv.GetEnvInt64("ENV_SIZED_INT", 1); got != 32768 {
+		t.Errorf("GetEnvInt64() = %d, want 32768", got)
+	}
+	if got := goenv.GetEnvInt32("ENV_SIZED_INT", 1); got != 32768 {
+		t.Errorf("GetEnvInt32() = %d, want 32768", got)
+	}
+	if got := goenv.GetEnvInt16("ENV_SIZED_INT", 1); got != 1 {
+		t.Errorf("GetEnvInt16() = %d, want fallback 1 on overflow", got)
+	}
+	if got := goenv.GetEnvInt8("ENV_SIZED_INT", 2); got != 2 {
+		t.Errorf("GetEnvInt8() = %d, want fallback 2 on overflow", got)
+	}
+	if got := goenv.GetEnvInt16("ENV_SIZED_INT_MISSING", 3); got != 3 {
+		t.Errorf("GetEnvInt16() = %d, want fallback 3 when missing", got)
+	}
+}
+
+func TestMustGetEnvSizedInts(t *testing.T) {
+	tests := []struct {
+		name      string
+		must      func(string) int64
+		value     string
+		want      int64
+		wantPanic bool
+	}{
+		{name: "int64", must: func(k string) int64 { return goenv.MustGetEnvInt64(k) }, value: "-5", want: -5},
+		{name: "int32", must: func(k string) int64 { return int64(goenv.MustGetEnvInt32(k)) }, value: "70000", want: 70000},
+		{name: "int16 overflow", must: func(k string) int64 { return int64(goenv.MustGetEnvInt16(k)) }, value: "70000", wantPanic: true},
+		{name: "int8 invalid", must: func(k string) int64 { return int64(goenv.MustGetEnvInt8(k)) }, value: "x", wantPanic: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("ENV_SIZED_INT", tt.value)
+			defer expectPanic(t, tt.wantPanic)()
+			if got := tt.must("ENV_SIZED_INT"); got != tt.want {
+				t.Errorf("got %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+/* ---------- int base ---------- */
+
+func TestTryGetEnvIntBase(t *testing.T) {
+	tests := []struct {
+		name    string
+		set     bool
+		value   string
+		base    int
+		want    int
+		wantErr bool
+	}{
+		{name: "hex", set: true, value: "1F", base: 16, want: 31},
+		{name: "hex lower", set: true, value: "ff", base: 16, want: 255},
+		{name: "octal", set: true, value: "755", base: 8, want: 493},
+		{name: "binary", set: true, value: "1011", base: 2, want: 11},
+		{name: "auto hex", set: true, value: "0xFF", base: 0, want: 255},
+		{name: "auto octal", set: true, value: "0o17", base: 0, want: 15},
+		{name: "auto legacy octal", set: true, value: "017", base: 0, want: 15},
+		{name: "auto binary", set: true, value: "0b101", base: 0, want: 5},
+		{name: "auto decimal", set: true, value: "-42", base: 0, want: -42},
+		{name: "prefix without base 0", set: true, value: "0xFF", base: 16, wantErr: true},
+		{name: "digit out of base", set: true, value: "129", base: 8, wantErr: true},
+		{name: "invalid base", set: true, value: "1", base: 1, wantErr: true},
+		{name: "missing", set: false, base: 16, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.set {
+				t.Setenv("ENV_INT_BASE", tt.value)
+			}
+			got, err := goenv.TryGetEnvIntBase("ENV_INT_BASE", tt.base)
+			if err != nil {
+				if !tt.wantErr {
+					t.Errorf("TryGetEnvIntBase() failed: %v", err)
+				}
+				return
+			}
+			if tt.wantErr {
+				t.Fatal("TryGetEnvIntBase() succeeded unexpectedly")
+			}
+			if got != tt.want {
+				t.Errorf("TryGetEnvIntBase() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetEnvIntBase(t *testing.T) {
+	t.Setenv("ENV_INT_BASE", "0x10")
+	if got := goenv.GetEnvIntBase("ENV_INT_BASE", 0, 1); got != 16 {
+		t.Errorf("GetEnvIntBase() = %d, want 16", got)
+	}
+	if got := goenv.GetEnvIntBase("ENV_INT_BASE", 10, 1); got != 1 {
+		t.Errorf("GetEnvIntBase() = %d, want fallback 1", got)
+	}
+	if got := goenv.GetEnvInt("ENV_INT_BASE", 2); got != 2 {
+		t.Errorf("GetEnvInt() = %d, want fallback 2 for a hex value", got)
+	}
+	func() {
+		defer expectPanic(t, false)()
+		if got := goenv.MustGetEnvIntBase("ENV_INT_BASE", 0); got != 16 {
+			t.Errorf("MustGetEnvIntBase() = %d, want 16", got)
+		}
+	}()
+	func() {
+		defer expectPanic(t, true)()
+		goenv.MustGetEnvIntBase("ENV_INT_BASE", 8)
+	}()
+}
+
+/* ---------- int range ---------- */
+
+func TestTryGetEnvIntRange(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		want    int
+		wantErr string
+	}{
+		{name: "lower bound", value: "1", want: 1},
+		{name: "upper bound", value: "65535", want: 65535},
+		{name: "inside", value: "8080", want: 8080},
+		{name: "below lower bound", value: "0", wantErr: "value 0 out of range [1, 65535]"},
+		{name: "above upper bound", value: "65536", wantErr: "value 65536 out of range [1, 65535]"},
+		{name: "far above", value: "70000", wantErr: "value 70000 out of range [1, 65535]"},
+		{name: "not a number", value: "http", wantErr: "unable to convert"},
+		{name: "empty", value: "", wantErr: "unable to find"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("ENV_INT_RANGE", tt.value)
+			got, err := goenv.TryGetEnvIntRange("ENV_INT_RANGE", 1, 65535)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("TryGetEnvIntRange() error = %v, want it to contain %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("TryGetEnvIntRange() failed: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("TryGetEnvIntRange() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetEnvIntRange(t *testing.T) {
+	t.Setenv("ENV_INT_RANGE", "443")
+	if got := goenv.GetEnvIntRange("ENV_INT_RANGE", 1, 65535, 8080); got != 443 {
+		t.Errorf("GetEnvIntRange() = %d, want 443", got)
+	}
+	t.Setenv("ENV_INT_RANGE", "70000")
+	if got := goenv.GetEnvIntRange("ENV_INT_RANGE", 1, 65535, 8080); got != 8080 {
+		t.Errorf("GetEnvIntRange() = %d, want fallback 8080", got)
+	}
+	func() {
+		defer expectPanic(t, true)()
+		goenv.MustGetEnvIntRange("ENV_INT_RANGE", 1, 65535)
+	}()
+	func() {
+		defer expectPanic(t, false)()
+		if got := goenv.MustGetEnvIntRange("ENV_INT_RANGE", 0, 70000); got != 70000 {
+			t.Errorf("MustGetEnvIntRange() = %d, want 70000", got)
+		}
+	}()
+}
@@ -0,0 +1,49 @@
+package goenv
+
+import (
+	"strings"
+	"sync"
+)
+
+// Interner deduplicates the strings returned by its getters so that equal elements
+// read across calls share a single backing allocation. This keeps memory flat when
+// large, repetitive lists are read many times.
+// The zero value is ready to use, and an Interner is safe for concurrent use.
+type Interner struct {
+	mu   sync.Mutex
+	pool map[string]string
+}
+
+// GetEnvStringSlice returns the comma-separated elements of the environment variable
+// named by key, trimmed and with empty elements dropped. Every element is interned,
+// so identical values returned by this Interner share memory.
+// If the variable is unset, empty, or contains no elements, it returns fallback unchanged.
+func (in *Interner) GetEnvStringSlice(key string, fallback []string) []string {
+	elems, err := TryGetEnvStringSlice(key)
+	if err != nil {
+		recordFallback(key)
+		return fallback
+	}
+	recordHit()
+
+	in.mu.Lock()
+	defer in.mu.Unlock()
+	for i, e := range elems {
+		elems[i] = in.intern(e)
+	}
+	return elems
+}
+
+// intern returns the canonical copy of s. The caller must hold in.mu.
+func (in *Interner) intern(s string) string {
+	if c, ok := in.pool[s]; ok {
+		return c
+	}
+	if in.pool == nil {
+		in.pool = make(map[string]string)
+	}
+	// Clone so the pool does not keep the whole raw env value alive.
+	c := strings.Clone(s)
+	in.pool[c] = c
+	return c
+}
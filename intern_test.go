@@ -0,0 +1,47 @@
+package goenv_test
+
+import (
+	"slices"
+	"testing"
+	"unsafe"
+
+	"github.com/battlej07/goenv"
+)
+
+/* ---------- Interner ---------- */
+
+func TestInternerGetEnvStringSlice(t *testing.T) {
+	t.Run("values and fallback", func(t *testing.T) {
+		var in goenv.Interner
+
+		t.Setenv("INTERN_LIST", " a, b ,,c ")
+		if got, want := in.GetEnvStringSlice("INTERN_LIST", nil), []string{"a", "b", "c"}; !slices.Equal(got, want) {
+			t.Errorf("GetEnvStringSlice() = %q, want %q", got, want)
+		}
+
+		fallback := []string{"x"}
+		if got := in.GetEnvStringSlice("INTERN_MISSING", fallback); !slices.Equal(got, fallback) {
+			t.Errorf("GetEnvStringSlice() = %q, want %q", got, fallback)
+		}
+	})
+
+	t.Run("identical elements share memory", func(t *testing.T) {
+		var in goenv.Interner
+
+		t.Setenv("INTERN_A", "alpha,beta,alpha")
+		t.Setenv("INTERN_B", "beta,alpha")
+		a := in.GetEnvStringSlice("INTERN_A", nil)
+		b := in.GetEnvStringSlice("INTERN_B", nil)
+
+		same := func(x, y string) bool { return unsafe.StringData(x) == unsafe.StringData(y) }
+		if !same(a[0], a[2]) {
+			t.Errorf("repeated element within a call is not interned")
+		}
+		if !same(a[0], b[1]) {
+			t.Errorf("alpha from two calls is not interned")
+		}
+		if !same(a[1], b[0]) {
+			t.Errorf("beta from two calls is not interned")
+		}
+	})
+}
@@ -0,0 +1,83 @@
+package goenv
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// GetEnvJSON returns the value of the environment variable named by key unmarshaled
+// from JSON into a T, e.g. `{"a":true,"b":false}` into a map[string]bool. If the
+// variable is unset, empty, or cannot be unmarshaled into T, it returns fallback.
+func GetEnvJSON[T any](key string, fallback T) T {
+	v, err := TryGetEnvJSON[T](key)
+	return orFallback(key, v, err, fallback)
+}
+
+// TryGetEnvJSON returns the value of the environment variable named by key unmarshaled
+// from JSON into a T with json.Unmarshal. It returns an error wrapping the JSON syntax
+// or type error if the value cannot be unmarshaled, or if the variable is unset or empty.
+func TryGetEnvJSON[T any](key string) (T, error) {
+	var out T
+	v, err := tryGetEnv(key, typeName[T]())
+	if err != nil {
+		return out, err
+	}
+	if err := json.Unmarshal([]byte(v), &out); err != nil {
+		var zero T
+		return zero, parseErrorf(key, "unable to parse %q as JSON: %w", v, err)
+	}
+	return out, nil
+}
+
+// MustGetEnvJSON returns the value of the environment variable named by key unmarshaled
+// from JSON into a T. It panics if the variable is unset, empty, or cannot be
+// unmarshaled into T.
+func MustGetEnvJSON[T any](key string) T {
+	v, err := TryGetEnvJSON[T](key)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// TryGetEnvJSONNumberSlice returns the JSON array of numbers in the environment variable
+// named by key as json.Number values, e.g. `[1, 12345678901234567890, 0.10]`. Each number
+// keeps its exact textual form, so large integers and decimals lose no precision.
+// It returns an error if the variable is unset, empty, is not a JSON array of numbers,
+// or has trailing data after the array.
+func TryGetEnvJSONNumberSlice(key string) ([]json.Number, error) {
+	v, err := tryGetEnv(key, "[]json.Number")
+	if err != nil {
+		return nil, err
+	}
+
+	dec := json.NewDecoder(strings.NewReader(v))
+	dec.UseNumber()
+
+	var nums []json.Number
+	if err := dec.Decode(&nums); err != nil {
+		return nil, parseErrorf(key, "unable to parse %q as JSON number array: %w", v, err)
+	}
+	if _, err := dec.Token(); !errors.Is(err, io.EOF) {
+		return nil, parseErrorf(key, "unable to parse %q as JSON number array: unexpected data after array", v)
+	}
+	return nums, nil
+}
+
+// GetEnvStringSliceJSONDefault returns the comma-separated elements of the environment
+// variable named by key, or the JSON array of strings in jsonDefault, e.g.
+// `["a", "b"]`, if the variable is unset, empty, or contains no elements. The default
+// is decoded on every call and a malformed one panics, even when the variable is set,
+// since it is a programming error rather than a configuration error.
+func GetEnvStringSliceJSONDefault(key, jsonDefault string) []string {
+	var fallback []string
+	if err := json.Unmarshal([]byte(jsonDefault), &fallback); err != nil {
+		panic(fmt.Errorf("unable to parse default %q as JSON string array: %w", jsonDefault, err))
+	}
+
+	v, err := TryGetEnvStringSlice(key)
+	return orFallback(key, v, err, fallback)
+}
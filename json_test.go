@@ -0,0 +1,156 @@
+package goenv_test
+
+import (
+	"encoding/json"
+	"errors"
+	"maps"
+	"slices"
+	"testing"
+
+	"github.com/battlej07/goenv"
+)
+
+/* ---------- JSON number slice ---------- */
+
+func TestTryGetEnvJSONNumberSlice(t *testing.T) {
+	tests := []struct {
+		name    string
+		key     string
+		set     bool
+		value   string
+		want    []json.Number
+		wantErr bool
+	}{
+		{name: "integers", key: "TRY_JSON_NUMS", set: true, value: "[1, 2, -3]", want: []json.Number{"1", "2", "-3"}},
+		{name: "large values", key: "TRY_JSON_NUMS", set: true, value: "[12345678901234567890123, 9007199254740993]", want: []json.Number{"12345678901234567890123", "9007199254740993"}},
+		{name: "decimals", key: "TRY_JSON_NUMS", set: true, value: "[0.10, 1e-7, 3.14159265358979323846]", want: []json.Number{"0.10", "1e-7", "3.14159265358979323846"}},
+		{name: "empty array", key: "TRY_JSON_NUMS", set: true, value: "[]", want: []json.Number{}},
+		{name: "strings -> err", key: "TRY_JSON_NUMS", set: true, value: `["a"]`, wantErr: true},
+		{name: "not json -> err", key: "TRY_JSON_NUMS", set: true, value: "1,2", wantErr: true},
+		{name: "trailing data -> err", key: "TRY_JSON_NUMS", set: true, value: "[1] [2]", wantErr: true},
+		{name: "empty -> err", key: "TRY_JSON_NUMS", set: true, value: "", wantErr: true},
+		{name: "missing -> err", key: "TRY_JSON_NUMS", set: false, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.set {
+				t.Setenv(tt.key, tt.value)
+			}
+			got, err := goenv.TryGetEnvJSONNumberSlice(tt.key)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("TryGetEnvJSONNumberSlice() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && !slices.Equal(got, tt.want) {
+				t.Errorf("TryGetEnvJSONNumberSlice() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+/* ---------- JSON default ---------- */
+
+func TestGetEnvStringSliceJSONDefault(t *testing.T) {
+	tests := []struct {
+		name        string
+		set         bool
+		value       string
+		jsonDefault string
+		want        []string
+	}{
+		{name: "env", set: true, value: "a, b", jsonDefault: `["x"]`, want: []string{"a", "b"}},
+		{name: "missing -> default", set: false, jsonDefault: `["x", "y"]`, want: []string{"x", "y"}},
+		{name: "no elements -> default", set: true, value: ",", jsonDefault: `["x"]`, want: []string{"x"}},
+		{name: "empty array default", set: false, jsonDefault: `[]`, want: []string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.set {
+				t.Setenv("ENV_JSON_DEFAULT", tt.value)
+			}
+			if got := goenv.GetEnvStringSliceJSONDefault("ENV_JSON_DEFAULT", tt.jsonDefault); !slices.Equal(got, tt.want) {
+				t.Errorf("GetEnvStringSliceJSONDefault() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetEnvStringSliceJSONDefaultPanics(t *testing.T) {
+	t.Setenv("ENV_JSON_DEFAULT", "a,b")
+	defer func() {
+		if recover() == nil {
+			t.Error("GetEnvStringSliceJSONDefault() did not panic on a malformed default")
+		}
+	}()
+	goenv.GetEnvStringSliceJSONDefault("ENV_JSON_DEFAULT", `["x",`)
+}
+
+/* ---------- JSON generic ---------- */
+
+type jsonConfig struct {
+	Name    string   `json:"name"`
+	Retries int      `json:"retries"`
+	Tags    []string `json:"tags"`
+}
+
+func TestTryGetEnvJSON(t *testing.T) {
+	t.Run("struct", func(t *testing.T) {
+		t.Setenv("ENV_JSON", `{"name":"api","retries":3,"tags":["a","b"]}`)
+		got, err := goenv.TryGetEnvJSON[jsonConfig]("ENV_JSON")
+		if err != nil {
+			t.Fatalf("TryGetEnvJSON() failed: %v", err)
+		}
+		if got.Name != "api" || got.Retries != 3 || !slices.Equal(got.Tags, []string{"a", "b"}) {
+			t.Errorf("TryGetEnvJSON() = %+v", got)
+		}
+	})
+	t.Run("map", func(t *testing.T) {
+		t.Setenv("ENV_JSON", `{"a":true,"b":false}`)
+		got, err := goenv.TryGetEnvJSON[map[string]bool]("ENV_JSON")
+		if err != nil || !maps.Equal(got, map[string]bool{"a": true, "b": false}) {
+			t.Errorf("TryGetEnvJSON() = %v, %v", got, err)
+		}
+	})
+	t.Run("slice", func(t *testing.T) {
+		t.Setenv("ENV_JSON", `[1, 2, 3]`)
+		got, err := goenv.TryGetEnvJSON[[]int]("ENV_JSON")
+		if err != nil || !slices.Equal(got, []int{1, 2, 3}) {
+			t.Errorf("TryGetEnvJSON() = %v, %v", got, err)
+		}
+	})
+	t.Run("malformed", func(t *testing.T) {
+		t.Setenv("ENV_JSON", `{"a":true,`)
+		_, err := goenv.TryGetEnvJSON[map[string]bool]("ENV_JSON")
+		var syntaxErr *json.SyntaxError
+		if !errors.As(err, &syntaxErr) || !errors.Is(err, goenv.ErrParse) {
+			t.Errorf("TryGetEnvJSON() error = %v, want a wrapped *json.SyntaxError", err)
+		}
+	})
+	t.Run("wrong type", func(t *testing.T) {
+		t.Setenv("ENV_JSON", `["x"]`)
+		if _, err := goenv.TryGetEnvJSON[[]int]("ENV_JSON"); err == nil {
+			t.Error("TryGetEnvJSON() succeeded unexpectedly")
+		}
+	})
+	t.Run("missing", func(t *testing.T) {
+		t.Setenv("ENV_JSON", "")
+		if _, err := goenv.TryGetEnvJSON[[]int]("ENV_JSON"); !errors.Is(err, goenv.ErrNotFound) {
+			t.Errorf("TryGetEnvJSON() error = %v, want ErrNotFound", err)
+		}
+	})
+}
+
+func TestGetEnvJSON(t *testing.T) {
+	fallback := []int{9}
+	t.Setenv("ENV_JSON", `[1, 2]`)
+	if got := goenv.GetEnvJSON("ENV_JSON", fallback); !slices.Equal(got, []int{1, 2}) {
+		t.Errorf("GetEnvJSON() = %v, want [1 2]", got)
+	}
+	t.Setenv("ENV_JSON", `[1, 2`)
+	if got := goenv.GetEnvJSON("ENV_JSON", fallback); !slices.Equal(got, fallback) {
+		t.Errorf("GetEnvJSON() = %v, want fallback", got)
+	}
+	func() {
+		defer expectPanic(t, true)()
+		goenv.MustGetEnvJSON[[]int]("ENV_JSON")
+	}()
+}
@@ -0,0 +1,33 @@
+package goenv
+
+import "sync/atomic"
+
+// LiveSlice holds the comma-separated elements of an environment variable and lets
+// them be re-read at runtime without blocking readers, for zero-downtime reloads.
+// A LiveSlice is safe for concurrent use and must be created with NewLiveSlice.
+type LiveSlice struct {
+	key      string
+	fallback []string
+	value    atomic.Value // []string
+}
+
+// NewLiveSlice returns a LiveSlice for the environment variable named by key, loaded
+// immediately. Values are parsed like the other comma-separated slice getters, and
+// fallback is used whenever the variable is unset, empty, or contains no elements.
+func NewLiveSlice(key string, fallback []string) *LiveSlice {
+	l := &LiveSlice{key: key, fallback: fallback}
+	l.Reload()
+	return l
+}
+
+// Load returns the most recently loaded elements. It never blocks, so it can be called
+// on hot paths while Reload runs concurrently. Callers must not modify the result.
+func (l *LiveSlice) Load() []string {
+	return l.value.Load().([]string)
+}
+
+// Reload re-reads the environment variable and atomically replaces the value returned
+// by Load.
+func (l *LiveSlice) Reload() {
+	l.value.Store(GetEnvStringSlice(l.key, l.fallback))
+}
@@ -0,0 +1,67 @@
+package goenv_test
+
+import (
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/battlej07/goenv"
+)
+
+/* ---------- LiveSlice ---------- */
+
+func TestLiveSlice(t *testing.T) {
+	t.Run("load and reload", func(t *testing.T) {
+		t.Setenv("LIVE_SLICE", "a,b")
+		l := goenv.NewLiveSlice("LIVE_SLICE", []string{"x"})
+		if got, want := l.Load(), []string{"a", "b"}; !slices.Equal(got, want) {
+			t.Errorf("Load() = %q, want %q", got, want)
+		}
+
+		t.Setenv("LIVE_SLICE", "c")
+		if got, want := l.Load(), []string{"a", "b"}; !slices.Equal(got, want) {
+			t.Errorf("Load() before Reload = %q, want %q", got, want)
+		}
+		l.Reload()
+		if got, want := l.Load(), []string{"c"}; !slices.Equal(got, want) {
+			t.Errorf("Load() after Reload = %q, want %q", got, want)
+		}
+	})
+
+	t.Run("fallback", func(t *testing.T) {
+		l := goenv.NewLiveSlice("LIVE_SLICE_MISSING", nil)
+		if got := l.Load(); got != nil {
+			t.Errorf("Load() = %q, want nil", got)
+		}
+	})
+
+	// Run with -race to check that Load and Reload do not race.
+	t.Run("concurrent load and reload", func(t *testing.T) {
+		t.Setenv("LIVE_SLICE", "a,b,c")
+		l := goenv.NewLiveSlice("LIVE_SLICE", nil)
+
+		var wg sync.WaitGroup
+		for i := 0; i < 8; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for j := 0; j < 1000; j++ {
+					if got := l.Load(); len(got) != 3 {
+						t.Errorf("Load() = %q, want 3 elements", got)
+						return
+					}
+				}
+			}()
+		}
+		for i := 0; i < 2; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for j := 0; j < 200; j++ {
+					l.Reload()
+				}
+			}()
+		}
+		wg.Wait()
+	})
+}
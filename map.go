@@ -0,0 +1,223 @@
+package goenv
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// TryGetEnvQuotedMap returns the key/value pairs of the environment variable named by key,
+// given as comma-separated k=v entries whose values may be double-quoted, e.g.
+// `Accept="a, b",X=1`. Commas inside quotes do not split entries, and quoted values are
+// unquoted using Go string literal rules. Keys and unquoted values are trimmed.
+// It returns an error if the variable is unset, empty, contains no entries, has an
+// unterminated quote, or contains an entry without '=' or with an empty key.
+func TryGetEnvQuotedMap(key string) (map[string]string, error) {
+	v, err := tryGetEnv(key, "map[string]string")
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := splitQuoted(v, ',')
+	if err != nil {
+		return nil, parseError(key, err)
+	}
+
+	m := make(map[string]string, len(entries))
+	for _, e := range entries {
+		k, val, ok := strings.Cut(e, "=")
+		k = strings.TrimSpace(k)
+		if !ok || k == "" {
+			return nil, parseErrorf(key, "malformed entry %q, expected key=value", e)
+		}
+		val = strings.TrimSpace(val)
+		if strings.HasPrefix(val, `"`) {
+			u, err := strconv.Unquote(val)
+			if err != nil {
+				return nil, parseErrorf(key, "malformed quoted value in entry %q: %w", e, err)
+			}
+			val = u
+		}
+		m[k] = val
+	}
+	if len(m) == 0 {
+		return nil, noElementsError(key)
+	}
+	return m, nil
+}
+
+// TryGetEnvMapAllowedKeys returns the key/value pairs of the environment variable named
+// by key, given as comma-separated k=v entries, e.g. "timeout=5,retries=3". Every key
+// must appear in allowed, which catches typos such as "tiemout=5". Keys and values are
+// trimmed, and a repeated key keeps its last value. It returns an error naming the
+// first unknown key, or if the variable is unset, empty, contains no entries, or has
+// a malformed entry.
+func TryGetEnvMapAllowedKeys(key string, allowed []string) (map[string]string, error) {
+	v, err := tryGetEnv(key, "map[string]string")
+	if err != nil {
+		return nil, err
+	}
+
+	m, err := parsePairs(v, nil)
+	if err != nil {
+		return nil, parseError(key, err)
+	}
+	// Check the entries rather than m so the first unknown key is the one reported.
+	for _, e := range splitList(v, ",") {
+		k, _, _ := strings.Cut(e, "=")
+		if k = strings.TrimSpace(k); !slices.Contains(allowed, k) {
+			return nil, parseErrorf(key, "unknown key %q, allowed keys are %v", k, allowed)
+		}
+	}
+	if len(m) == 0 {
+		return nil, noElementsError(key)
+	}
+	return m, nil
+}
+
+// TryGetEnvDurationMap returns the durations of the environment variable named by key,
+// given as comma-separated name:duration entries, e.g. "task1:1h,task2:30m". Each entry
+// is split on its first ':' and the value must be a valid time.ParseDuration string.
+// It returns an error naming the entry's key if the ':' is missing or the duration is
+// invalid, or if the variable is unset, empty, or contains no entries.
+func TryGetEnvDurationMap(key string) (map[string]time.Duration, error) {
+	v, err := tryGetEnv(key, "map[string]time.Duration")
+	if err != nil {
+		return nil, err
+	}
+
+	entries := splitList(v, ",")
+	m := make(map[string]time.Duration, len(entries))
+	for _, e := range entries {
+		k, val, ok := strings.Cut(e, ":")
+		k = strings.TrimSpace(k)
+		if !ok || k == "" {
+			return nil, parseErrorf(key, "malformed entry %q, expected key:duration", e)
+		}
+		d, err := time.ParseDuration(strings.TrimSpace(val))
+		if err != nil {
+			return nil, parseErrorf(key, "invalid duration for key %q: %w", k, err)
+		}
+		m[k] = d
+	}
+	if len(m) == 0 {
+		return nil, noElementsError(key)
+	}
+	return m, nil
+}
+
+// GetEnvMapKeyTransform returns the key/value pairs of the environment variable named by
+// key, given as comma-separated k=v entries, with keyFn applied to every key before it
+// is inserted, e.g. to canonicalize header names. When two keys transform to the same
+// key, the later entry wins. A nil keyFn leaves keys unchanged. If the variable is
+// unset, empty, contains no entries, or has a malformed entry, it returns fallback.
+func GetEnvMapKeyTransform(key string, keyFn func(string) string, fallback map[string]string) map[string]string {
+	v, err := tryGetEnv(key, "map[string]string")
+	if err != nil {
+		return orFallback(key, nil, err, fallback)
+	}
+	m, err := parsePairs(v, keyFn)
+	if err == nil && len(m) == 0 {
+		err = noElementsError(key)
+	}
+	return orFallback(key, m, err, fallback)
+}
+
+// GetEnvStringMap returns the key/value pairs of the environment variable named by key,
+// given as comma-separated k=v entries, e.g. "env=prod,team=payments". If the variable
+// is unset, empty, contains no entries, or has a malformed entry, it returns fallback.
+func GetEnvStringMap(key string, fallback map[string]string) map[string]string {
+	v, err := TryGetEnvStringMap(key)
+	return orFallback(key, v, err, fallback)
+}
+
+// TryGetEnvStringMap returns the key/value pairs of the environment variable named by
+// key, given as comma-separated k=v entries. Each entry is split on its first '=', keys
+// and values are trimmed, and a later duplicate key overwrites an earlier one.
+// It returns an error if the variable is unset, empty, contains no entries, or has an
+// entry without '=' or with an empty key.
+func TryGetEnvStringMap(key string) (map[string]string, error) {
+	v, err := tryGetEnv(key, "map[string]string")
+	if err != nil {
+		return nil, err
+	}
+	m, err := parsePairs(v, nil)
+	if err != nil {
+		return nil, parseError(key, err)
+	}
+	if len(m) == 0 {
+		return nil, noElementsError(key)
+	}
+	return m, nil
+}
+
+// MustGetEnvStringMap returns the key/value pairs of the environment variable named by
+// key, given as comma-separated k=v entries. It panics if the variable is unset, empty,
+// contains no entries, or has a malformed entry.
+func MustGetEnvStringMap(key string) map[string]string {
+	v, err := TryGetEnvStringMap(key)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// parsePairs parses comma-separated k=v entries into a map, splitting each entry on
+// its first '='. Keys and values are trimmed, keyFn is applied to keys unless nil,
+// blank entries are skipped, and later duplicates overwrite earlier ones.
+func parsePairs(v string, keyFn func(string) string) (map[string]string, error) {
+	entries := splitList(v, ",")
+	m := make(map[string]string, len(entries))
+	for _, e := range entries {
+		k, val, ok := strings.Cut(e, "=")
+		k = strings.TrimSpace(k)
+		if !ok || k == "" {
+			return nil, fmt.Errorf("malformed entry %q, expected key=value", e)
+		}
+		if keyFn != nil {
+			k = keyFn(k)
+		}
+		m[k] = strings.TrimSpace(val)
+	}
+	return m, nil
+}
+
+// splitQuoted splits v on sep, ignoring separators inside double-quoted sections.
+// Backslash escapes inside quotes are kept verbatim for later unquoting.
+// Blank entries are dropped.
+func splitQuoted(v string, sep rune) ([]string, error) {
+	var (
+		entries []string
+		cur     strings.Builder
+		inQuote bool
+		escaped bool
+	)
+	flush := func() {
+		if e := strings.TrimSpace(cur.String()); e != "" {
+			entries = append(entries, e)
+		}
+		cur.Reset()
+	}
+
+	for _, r := range v {
+		switch {
+		case escaped:
+			escaped = false
+		case inQuote && r == '\\':
+			escaped = true
+		case r == '"':
+			inQuote = !inQuote
+		case !inQuote && r == sep:
+			flush()
+			continue
+		}
+		cur.WriteRune(r)
+	}
+	if inQuote {
+		return nil, fmt.Errorf("unterminated quote in %q", v)
+	}
+	flush()
+	return entries, nil
+}
@@ -0,0 +1,236 @@
+package goenv_test
+
+import (
+	"maps"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/battlej07/goenv"
+)
+
+/* ---------- quoted map ---------- */
+
+func TestTryGetEnvQuotedMap(t *testing.T) {
+	tests := []struct {
+		name    string
+		key     string
+		set     bool
+		value   string
+		want    map[string]string
+		wantErr bool
+	}{
+		{name: "ok plain", key: "TRY_QMAP", set: true, value: "a=1, b = 2", want: map[string]string{"a": "1", "b": "2"}},
+		{name: "ok quoted comma", key: "TRY_QMAP", set: true, value: `Accept="a, b",X=1`, want: map[string]string{"Accept": "a, b", "X": "1"}},
+		{name: "ok escaped quote", key: "TRY_QMAP", set: true, value: `msg="say \"hi\", bye"`, want: map[string]string{"msg": `say "hi", bye`}},
+		{name: "ok equals in value", key: "TRY_QMAP", set: true, value: `q=a=b`, want: map[string]string{"q": "a=b"}},
+		{name: "missing '=' -> err", key: "TRY_QMAP", set: true, value: "a=1,oops", wantErr: true},
+		{name: "empty key -> err", key: "TRY_QMAP", set: true, value: "=1", wantErr: true},
+		{name: "unterminated quote -> err", key: "TRY_QMAP", set: true, value: `a="1,b=2`, wantErr: true},
+		{name: "empty -> err", key: "TRY_QMAP", set: true, value: "", wantErr: true},
+		{name: "no entries -> err", key: "TRY_QMAP", set: true, value: ",,", wantErr: true},
+		{name: "missing -> err", key: "TRY_QMAP", set: false, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.set {
+				t.Setenv(tt.key, tt.value)
+			}
+			got, err := goenv.TryGetEnvQuotedMap(tt.key)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("TryGetEnvQuotedMap() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && !maps.Equal(got, tt.want) {
+				t.Errorf("TryGetEnvQuotedMap() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+/* ---------- map with allowed keys ---------- */
+
+func TestTryGetEnvMapAllowedKeys(t *testing.T) {
+	allowed := []string{"timeout", "retries", "mode"}
+	tests := []struct {
+		name    string
+		set     bool
+		value   string
+		want    map[string]string
+		wantErr string
+	}{
+		{name: "all allowed", set: true, value: "timeout=5, retries = 3", want: map[string]string{"timeout": "5", "retries": "3"}},
+		{name: "duplicate keeps last", set: true, value: "mode=a,mode=b", want: map[string]string{"mode": "b"}},
+		{name: "unknown key -> err", set: true, value: "timeout=5,tiemout=6", wantErr: `"tiemout"`},
+		{name: "first unknown key named", set: true, value: "timeout=5,first=1,second=2,third=3,fourth=4,fifth=5", wantErr: `unknown key "first"`},
+		{name: "malformed -> err", set: true, value: "timeout", wantErr: "malformed"},
+		{name: "no entries -> err", set: true, value: ",,", wantErr: "no elements"},
+		{name: "missing -> err", set: false, wantErr: "TRY_MAP_ALLOWED"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.set {
+				t.Setenv("TRY_MAP_ALLOWED", tt.value)
+			}
+			got, err := goenv.TryGetEnvMapAllowedKeys("TRY_MAP_ALLOWED", allowed)
+			if (err != nil) != (tt.wantErr != "") {
+				t.Fatalf("TryGetEnvMapAllowedKeys() error = %v, wantErr %q", err, tt.wantErr)
+			}
+			if err != nil {
+				if !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("TryGetEnvMapAllowedKeys() error = %q, want it to mention %s", err, tt.wantErr)
+				}
+				return
+			}
+			if !maps.Equal(got, tt.want) {
+				t.Errorf("TryGetEnvMapAllowedKeys() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+/* ---------- duration map ---------- */
+
+func TestTryGetEnvDurationMap(t *testing.T) {
+	tests := []struct {
+		name    string
+		set     bool
+		value   string
+		want    map[string]time.Duration
+		wantErr string
+	}{
+		{name: "ok", set: true, value: "task1:1h, task2 : 30m", want: map[string]time.Duration{"task1": time.Hour, "task2": 30 * time.Minute}},
+		{name: "bad duration -> err", set: true, value: "task1:1h,task2:soon", wantErr: `"task2"`},
+		{name: "missing colon -> err", set: true, value: "task1", wantErr: "task1"},
+		{name: "no entries -> err", set: true, value: ",,", wantErr: "no elements"},
+		{name: "missing -> err", set: false, wantErr: "TRY_DUR_MAP"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.set {
+				t.Setenv("TRY_DUR_MAP", tt.value)
+			}
+			got, err := goenv.TryGetEnvDurationMap("TRY_DUR_MAP")
+			if (err != nil) != (tt.wantErr != "") {
+				t.Fatalf("TryGetEnvDurationMap() error = %v, wantErr %q", err, tt.wantErr)
+			}
+			if err != nil {
+				if !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("TryGetEnvDurationMap() error = %q, want it to mention %s", err, tt.wantErr)
+				}
+				return
+			}
+			if !maps.Equal(got, tt.want) {
+				t.Errorf("TryGetEnvDurationMap() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+/* ---------- map with key transform ---------- */
+
+func TestGetEnvMapKeyTransform(t *testing.T) {
+	canonical := func(k string) string { return strings.ToLower(strings.ReplaceAll(k, "_", "-")) }
+	fallback := map[string]string{"x": "y"}
+
+	tests := []struct {
+		name  string
+		set   bool
+		value string
+		keyFn func(string) string
+		want  map[string]string
+	}{
+		{
+			name:  "keys collide after transform, last wins",
+			set:   true,
+			value: "Content_Type=text/plain,X-Id=1,content-type=application/json",
+			keyFn: canonical,
+			want:  map[string]string{"content-type": "application/json", "x-id": "1"},
+		},
+		{name: "nil keyFn", set: true, value: "A=1,a=2", want: map[string]string{"A": "1", "a": "2"}},
+		{name: "malformed -> fallback", set: true, value: "A=1,B", keyFn: canonical, want: fallback},
+		{name: "no entries -> fallback", set: true, value: ",,", keyFn: canonical, want: fallback},
+		{name: "missing -> fallback", set: false, keyFn: canonical, want: fallback},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.set {
+				t.Setenv("ENV_MAP_KEYFN", tt.value)
+			}
+			if got := goenv.GetEnvMapKeyTransform("ENV_MAP_KEYFN", tt.keyFn, fallback); !maps.Equal(got, tt.want) {
+				t.Errorf("GetEnvMapKeyTransform() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+/* ---------- string map ---------- */
+
+func TestTryGetEnvStringMap(t *testing.T) {
+	tests := []struct {
+		name    string
+		set     bool
+		value   string
+		want    map[string]string
+		wantErr string
+	}{
+		{name: "ok", set: true, value: "env=prod,team=payments,region=us", want: map[string]string{"env": "prod", "team": "payments", "region": "us"}},
+		{name: "ok trimmed", set: true, value: " env = prod , team=  payments ", want: map[string]string{"env": "prod", "team": "payments"}},
+		{name: "ok duplicate last wins", set: true, value: "env=dev,env=prod", want: map[string]string{"env": "prod"}},
+		{name: "ok equals in value", set: true, value: "q=a=b", want: map[string]string{"q": "a=b"}},
+		{name: "ok empty value", set: true, value: "a=", want: map[string]string{"a": ""}},
+		{name: "malformed pair -> err", set: true, value: "env=prod,oops", wantErr: `"oops"`},
+		{name: "empty key -> err", set: true, value: "=prod", wantErr: `"=prod"`},
+		{name: "no entries -> err", set: true, value: " , ", wantErr: "no elements"},
+		{name: "empty -> err", set: true, value: "", wantErr: "unable to find"},
+		{name: "unset -> err", wantErr: "unable to find"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.set {
+				t.Setenv("TRY_SMAP", tt.value)
+			}
+			got, err := goenv.TryGetEnvStringMap("TRY_SMAP")
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("TryGetEnvStringMap() error = %v, want it to contain %s", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("TryGetEnvStringMap() failed: %v", err)
+			}
+			if !maps.Equal(got, tt.want) {
+				t.Errorf("TryGetEnvStringMap() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetEnvStringMap(t *testing.T) {
+	fallback := map[string]string{"env": "local"}
+
+	t.Setenv("GET_SMAP", "env=prod")
+	if got := goenv.GetEnvStringMap("GET_SMAP", fallback); !maps.Equal(got, map[string]string{"env": "prod"}) {
+		t.Errorf("GetEnvStringMap() = %v, want map[env:prod]", got)
+	}
+	for _, v := range []string{"", "oops"} {
+		t.Setenv("GET_SMAP", v)
+		if got := goenv.GetEnvStringMap("GET_SMAP", fallback); !maps.Equal(got, fallback) {
+			t.Errorf("GetEnvStringMap() with %q = %v, want fallback", v, got)
+		}
+	}
+}
+
+func TestMustGetEnvStringMap(t *testing.T) {
+	t.Setenv("MUST_SMAP", "a=1,b")
+	func() {
+		defer expectPanic(t, true)()
+		goenv.MustGetEnvStringMap("MUST_SMAP")
+	}()
+
+	t.Setenv("MUST_SMAP", "a=1")
+	func() {
+		defer expectPanic(t, false)()
+		goenv.MustGetEnvStringMap("MUST_SMAP")
+	}()
+}
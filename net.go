@@ -0,0 +1,162 @@
+package goenv
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+)
+
+// GetEnvIP returns the IP address in the environment variable named by key, either
+// IPv4 such as "10.0.0.1" or IPv6 such as "::1". If the variable is unset, empty, or
+// not a valid address, it returns fallback.
+func GetEnvIP(key string, fallback net.IP) net.IP {
+	v, err := TryGetEnvIP(key)
+	return orFallback(key, v, err, fallback)
+}
+
+// GetEnvCIDR returns the IP address and network of the CIDR notation value in the
+// environment variable named by key, e.g. "192.168.1.10/24". If the variable is unset,
+// empty, or not valid CIDR notation, it returns fallback and its base address, or nil
+// and nil for a nil fallback.
+func GetEnvCIDR(key string, fallback *net.IPNet) (net.IP, *net.IPNet) {
+	ip, ipNet, err := TryGetEnvCIDR(key)
+	if err != nil {
+		recordFallback(key)
+		if fallback == nil {
+			return nil, nil
+		}
+		return fallback.IP, fallback
+	}
+	recordHit()
+	return ip, ipNet
+}
+
+// TryGetEnvIP returns the IP address in the environment variable named by key, parsed
+// with net.ParseIP. It returns an error naming the value if it is not a valid address,
+// or if the variable is unset or empty.
+func TryGetEnvIP(key string) (net.IP, error) {
+	v, err := tryGetEnv(key, "net.IP")
+	if err != nil {
+		return nil, err
+	}
+	ip := net.ParseIP(v)
+	if ip == nil {
+		return nil, parseErrorf(key, "unable to parse %q as an IP address", v)
+	}
+	return ip, nil
+}
+
+// TryGetEnvCIDR returns the IP address and network of the CIDR notation value in the
+// environment variable named by key, parsed with net.ParseCIDR, so "10.0.0.7/24" yields
+// 10.0.0.7 and 10.0.0.0/24. It returns an error naming the value if it is not valid
+// CIDR notation, or if the variable is unset or empty.
+func TryGetEnvCIDR(key string) (net.IP, *net.IPNet, error) {
+	v, err := tryGetEnv(key, "*net.IPNet")
+	if err != nil {
+		return nil, nil, err
+	}
+	ip, ipNet, err := net.ParseCIDR(v)
+	if err != nil {
+		return nil, nil, parseErrorf(key, "unable to parse %q as CIDR: %w", v, err)
+	}
+	return ip, ipNet, nil
+}
+
+// MustGetEnvIP returns the IP address in the environment variable named by key.
+// It panics if the variable is unset, empty, or not a valid address.
+func MustGetEnvIP(key string) net.IP {
+	v, err := TryGetEnvIP(key)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// MustGetEnvCIDR returns the IP address and network of the CIDR notation value in the
+// environment variable named by key. It panics if the variable is unset, empty, or
+// not valid CIDR notation.
+func MustGetEnvCIDR(key string) (net.IP, *net.IPNet) {
+	ip, ipNet, err := TryGetEnvCIDR(key)
+	if err != nil {
+		panic(err)
+	}
+	return ip, ipNet
+}
+
+// GetEnvHostSlice returns the hosts of the environment variable named by key like
+// TryGetEnvHostSlice. If the variable is unset, empty, contains no elements, or has an
+// invalid entry, it returns fallback.
+func GetEnvHostSlice(key string, fallback []string) []string {
+	v, err := TryGetEnvHostSlice(key)
+	return orFallback(key, v, err, fallback)
+}
+
+// TryGetEnvHostSlice returns the comma-separated hosts of the environment variable named
+// by key, each either a hostname/IP address or a host:port pair, e.g.
+// "api.example.com,10.0.0.1:8080,[::1]:443". Hosts are lower-cased. It returns an error
+// naming the index of the first invalid entry, or if the variable is unset, empty, or
+// contains no elements.
+func TryGetEnvHostSlice(key string) ([]string, error) {
+	elems, err := TryGetEnvStringSlice(key)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, e := range elems {
+		h, err := normalizeHost(e)
+		if err != nil {
+			return nil, parseErrorf(key, "element %d %q is not a valid host: %w", i, e, err)
+		}
+		elems[i] = h
+	}
+	return elems, nil
+}
+
+// normalizeHost validates s as a host or host:port and returns it lower-cased.
+func normalizeHost(s string) (string, error) {
+	s = strings.ToLower(s)
+	if ip := net.ParseIP(s); ip != nil {
+		return s, nil
+	}
+
+	if !strings.Contains(s, ":") {
+		if !isHostname(s) {
+			return "", fmt.Errorf("invalid hostname")
+		}
+		return s, nil
+	}
+
+	host, port, err := net.SplitHostPort(s)
+	if err != nil {
+		return "", err
+	}
+
+	if n, err := strconv.Atoi(port); err != nil || n < 1 || n > 65535 {
+		return "", fmt.Errorf("invalid port %q", port)
+	}
+	if net.ParseIP(host) == nil && !isHostname(host) {
+		return "", fmt.Errorf("invalid hostname %q", host)
+	}
+	return net.JoinHostPort(host, port), nil
+}
+
+// isHostname reports whether s follows RFC 1123 hostname rules: dot-separated
+// labels of 1 to 63 letters, digits, or hyphens, not starting or ending with a
+// hyphen, and at most 253 characters in total.
+func isHostname(s string) bool {
+	if s == "" || len(s) > 253 {
+		return false
+	}
+	for _, label := range strings.Split(s, ".") {
+		if label == "" || len(label) > 63 || label[0] == '-' || label[len(label)-1] == '-' {
+			return false
+		}
+		for _, r := range label {
+			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+				return false
+			}
+		}
+	}
+	return true
+}
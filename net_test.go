@@ -0,0 +1,164 @@
+package goenv_test
+
+import (
+	"net"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/battlej07/goenv"
+)
+
+/* ---------- host slice ---------- */
+
+func TestTryGetEnvHostSlice(t *testing.T) {
+	tests := []struct {
+		name    string
+		set     bool
+		value   string
+		want    []string
+		wantErr bool
+	}{
+		{name: "hosts", set: true, value: "API.Example.com, localhost,10.0.0.1", want: []string{"api.example.com", "localhost", "10.0.0.1"}},
+		{name: "host:port", set: true, value: "db.internal:5432", want: []string{"db.internal:5432"}},
+		{name: "ipv6", set: true, value: "::1,[2001:DB8::1]:443", want: []string{"::1", "[2001:db8::1]:443"}},
+		{name: "invalid host -> err", set: true, value: "ok.com,bad_host!", wantErr: true},
+		{name: "leading hyphen -> err", set: true, value: "-bad.com", wantErr: true},
+		{name: "bad port -> err", set: true, value: "ok.com:99999", wantErr: true},
+		{name: "empty port -> err", set: true, value: "ok.com:", wantErr: true},
+		{name: "too many colons -> err", set: true, value: "a:b:c", wantErr: true},
+		{name: "no elements -> err", set: true, value: ",,", wantErr: true},
+		{name: "missing -> err", set: false, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.set {
+				t.Setenv("TRY_HOSTS", tt.value)
+			}
+			got, err := goenv.TryGetEnvHostSlice("TRY_HOSTS")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("TryGetEnvHostSlice() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && !slices.Equal(got, tt.want) {
+				t.Errorf("TryGetEnvHostSlice() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetEnvHostSlice(t *testing.T) {
+	fallback := []string{"localhost"}
+	tests := []struct {
+		name  string
+		set   bool
+		value string
+		want  []string
+	}{
+		{name: "valid", set: true, value: "A.com,b.com:80", want: []string{"a.com", "b.com:80"}},
+		{name: "no elements -> fallback", set: true, value: ",,", want: fallback},
+		{name: "invalid -> fallback", set: true, value: "bad_host!", want: fallback},
+		{name: "missing -> fallback", set: false, want: fallback},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.set {
+				t.Setenv("ENV_HOSTS", tt.value)
+			}
+			if got := goenv.GetEnvHostSlice("ENV_HOSTS", fallback); !slices.Equal(got, tt.want) {
+				t.Errorf("GetEnvHostSlice() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+/* ---------- IP and CIDR ---------- */
+
+func TestTryGetEnvIP(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		want    net.IP
+		wantErr bool
+	}{
+		{name: "ipv4", value: "10.0.0.1", want: net.IPv4(10, 0, 0, 1)},
+		{name: "ipv6", value: "2001:db8::1", want: net.ParseIP("2001:db8::1")},
+		{name: "ipv6 loopback", value: "::1", want: net.IPv6loopback},
+		{name: "malformed", value: "10.0.0.256", wantErr: true},
+		{name: "hostname", value: "localhost", wantErr: true},
+		{name: "with cidr suffix", value: "10.0.0.1/24", wantErr: true},
+		{name: "empty", value: "", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("ENV_IP", tt.value)
+			got, err := goenv.TryGetEnvIP("ENV_IP")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("TryGetEnvIP() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				if tt.value != "" && !strings.Contains(err.Error(), strconv.Quote(tt.value)) {
+					t.Errorf("TryGetEnvIP() error = %v, want it to name %q", err, tt.value)
+				}
+				return
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("TryGetEnvIP() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTryGetEnvCIDR(t *testing.T) {
+	t.Setenv("ENV_CIDR", "192.168.1.10/24")
+	ip, ipNet, err := goenv.TryGetEnvCIDR("ENV_CIDR")
+	if err != nil {
+		t.Fatalf("TryGetEnvCIDR() failed: %v", err)
+	}
+	if !ip.Equal(net.IPv4(192, 168, 1, 10)) || ipNet.String() != "192.168.1.0/24" {
+		t.Errorf("TryGetEnvCIDR() = %v, %v, want 192.168.1.10, 192.168.1.0/24", ip, ipNet)
+	}
+	if !ipNet.Contains(net.IPv4(192, 168, 1, 200)) || ipNet.Contains(net.IPv4(192, 168, 2, 1)) {
+		t.Errorf("TryGetEnvCIDR() network %v has the wrong extent", ipNet)
+	}
+
+	for _, v := range []string{"192.168.1.10", "192.168.1.10/33", "nope/24"} {
+		t.Setenv("ENV_CIDR", v)
+		if _, _, err := goenv.TryGetEnvCIDR("ENV_CIDR"); err == nil || !strings.Contains(err.Error(), strconv.Quote(v)) {
+			t.Errorf("TryGetEnvCIDR() with %q error = %v, want it to name the value", v, err)
+		}
+	}
+}
+
+func TestGetEnvIPAndCIDR(t *testing.T) {
+	fallbackIP := net.IPv4(127, 0, 0, 1)
+	_, fallbackNet, _ := net.ParseCIDR("10.0.0.0/8")
+
+	t.Setenv("ENV_IP", "::1")
+	if got := goenv.GetEnvIP("ENV_IP", fallbackIP); !got.Equal(net.IPv6loopback) {
+		t.Errorf("GetEnvIP() = %v, want ::1", got)
+	}
+	if ip, ipNet := goenv.GetEnvCIDR("ENV_IP", fallbackNet); !ip.Equal(fallbackNet.IP) || ipNet != fallbackNet {
+		t.Errorf("GetEnvCIDR() = %v, %v, want fallback", ip, ipNet)
+	}
+	if ip, ipNet := goenv.GetEnvCIDR("ENV_IP", nil); ip != nil || ipNet != nil {
+		t.Errorf("GetEnvCIDR() = %v, %v, want nil, nil", ip, ipNet)
+	}
+
+	t.Setenv("ENV_IP", "fe80::/10")
+	if got := goenv.GetEnvIP("ENV_IP", fallbackIP); !got.Equal(fallbackIP) {
+		t.Errorf("GetEnvIP() = %v, want fallback", got)
+	}
+	if _, ipNet := goenv.GetEnvCIDR("ENV_IP", fallbackNet); ipNet.String() != "fe80::/10" {
+		t.Errorf("GetEnvCIDR() network = %v, want fe80::/10", ipNet)
+	}
+
+	func() {
+		defer expectPanic(t, false)()
+		goenv.MustGetEnvCIDR("ENV_IP")
+	}()
+	func() {
+		defer expectPanic(t, true)()
+		goenv.MustGetEnvIP("ENV_IP")
+	}()
+}
@@ -0,0 +1,109 @@
+package goenv
+
+import (
+	"cmp"
+	"fmt"
+	"time"
+)
+
+// Option configures how a *With getter, such as GetEnvIntWith, accepts a parsed
+// value of type T. The same options work with every such getter of that type.
+type Option[T any] func(*options[T])
+
+// options holds the settings collected from a list of Option values.
+type options[T any] struct {
+	validators []func(T) error
+}
+
+// WithValidator rejects a parsed value for which fn returns a non-nil error.
+// Validators run in the order they are given, and the first failure wins.
+func WithValidator[T any](fn func(T) error) Option[T] {
+	return func(o *options[T]) {
+		o.validators = append(o.validators, fn)
+	}
+}
+
+// WithRange rejects a parsed value outside [min, max].
+func WithRange[T cmp.Ordered](min, max T) Option[T] {
+	return WithValidator(func(v T) error {
+		if v < min || v > max {
+			return fmt.Errorf("value %v out of range [%v, %v]", v, min, max)
+		}
+		return nil
+	})
+}
+
+// GetEnvIntWith returns the integer value of the environment variable named by key if
+// it passes every option in opts. If the variable is unset, empty, cannot be parsed,
+// or is rejected by an option, it returns fallback.
+func GetEnvIntWith(key string, fallback int, opts ...Option[int]) int {
+	v, err := TryGetEnvIntWith(key, opts...)
+	return orFallback(key, v, err, fallback)
+}
+
+// GetEnvDurationWith returns the duration value of the environment variable named by
+// key if it passes every option in opts. If the variable is unset, empty, cannot be
+// parsed, or is rejected by an option, it returns fallback.
+func GetEnvDurationWith(key string, fallback time.Duration, opts ...Option[time.Duration]) time.Duration {
+	v, err := TryGetEnvDurationWith(key, opts...)
+	return orFallback(key, v, err, fallback)
+}
+
+// TryGetEnvIntWith returns the integer value of the environment variable named by key
+// after checking it against opts. It returns an error if the variable is unset, empty,
+// cannot be parsed, or is rejected by an option.
+func TryGetEnvIntWith(key string, opts ...Option[int]) (int, error) {
+	v, err := TryGetEnvInt(key)
+	if err != nil {
+		return 0, err
+	}
+	return applyOptions(key, v, opts)
+}
+
+// TryGetEnvDurationWith returns the duration value of the environment variable named
+// by key after checking it against opts. It returns an error if the variable is unset,
+// empty, cannot be parsed, or is rejected by an option.
+func TryGetEnvDurationWith(key string, opts ...Option[time.Duration]) (time.Duration, error) {
+	v, err := TryGetEnvDuration(key)
+	if err != nil {
+		return 0, err
+	}
+	return applyOptions(key, v, opts)
+}
+
+// MustGetEnvIntWith returns the integer value of the environment variable named by key
+// after checking it against opts. It panics if the variable is unset, empty, cannot be
+// parsed, or is rejected by an option.
+func MustGetEnvIntWith(key string, opts ...Option[int]) int {
+	v, err := TryGetEnvIntWith(key, opts...)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// MustGetEnvDurationWith returns the duration value of the environment variable named
+// by key after checking it against opts. It panics if the variable is unset, empty,
+// cannot be parsed, or is rejected by an option.
+func MustGetEnvDurationWith(key string, opts ...Option[time.Duration]) time.Duration {
+	v, err := TryGetEnvDurationWith(key, opts...)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// applyOptions runs the validators in opts against v, the parsed value of key.
+func applyOptions[T any](key string, v T, opts []Option[T]) (T, error) {
+	var o options[T]
+	for _, opt := range opts {
+		opt(&o)
+	}
+	for _, validate := range o.validators {
+		if err := validate(v); err != nil {
+			var zero T
+			return zero, parseErrorf(key, "env variable with key %s: %w", key, err)
+		}
+	}
+	return v, nil
+}
@@ -0,0 +1,105 @@
+package goenv_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/battlej07/goenv"
+)
+
+/* ---------- options ---------- */
+
+var errEven = errors.New("value must be odd")
+
+func rejectEven(v int) error {
+	if v%2 == 0 {
+		return errEven
+	}
+	return nil
+}
+
+func TestTryGetEnvIntWith(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		opts    []goenv.Option[int]
+		want    int
+		wantErr string
+	}{
+		{name: "no options", value: "4", want: 4},
+		{name: "odd passes validator", value: "7", opts: []goenv.Option[int]{goenv.WithValidator(rejectEven)}, want: 7},
+		{name: "even rejected", value: "8", opts: []goenv.Option[int]{goenv.WithValidator(rejectEven)}, wantErr: "value must be odd"},
+		{name: "in range", value: "10", opts: []goenv.Option[int]{goenv.WithRange(1, 10)}, want: 10},
+		{name: "out of range", value: "11", opts: []goenv.Option[int]{goenv.WithRange(1, 10)}, wantErr: "value 11 out of range [1, 10]"},
+		{name: "first failure wins", value: "12", opts: []goenv.Option[int]{goenv.WithRange(1, 10), goenv.WithValidator(rejectEven)}, wantErr: "out of range"},
+		{name: "combined pass", value: "9", opts: []goenv.Option[int]{goenv.WithRange(1, 10), goenv.WithValidator(rejectEven)}, want: 9},
+		{name: "parse error", value: "nine", opts: []goenv.Option[int]{goenv.WithValidator(rejectEven)}, wantErr: "unable to convert"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("ENV_INT_WITH", tt.value)
+			got, err := goenv.TryGetEnvIntWith("ENV_INT_WITH", tt.opts...)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("TryGetEnvIntWith() error = %v, want it to contain %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("TryGetEnvIntWith() failed: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("TryGetEnvIntWith() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetEnvIntWith(t *testing.T) {
+	validator := goenv.WithValidator(rejectEven)
+
+	t.Setenv("ENV_INT_WITH", "3")
+	if got := goenv.GetEnvIntWith("ENV_INT_WITH", 1, validator); got != 3 {
+		t.Errorf("GetEnvIntWith() = %d, want 3", got)
+	}
+
+	t.Setenv("ENV_INT_WITH", "2")
+	if got := goenv.GetEnvIntWith("ENV_INT_WITH", 1, validator); got != 1 {
+		t.Errorf("GetEnvIntWith() = %d, want fallback 1", got)
+	}
+	_, err := goenv.TryGetEnvIntWith("ENV_INT_WITH", validator)
+	if !errors.Is(err, errEven) || !errors.Is(err, goenv.ErrParse) {
+		t.Errorf("TryGetEnvIntWith() error = %v, want errEven and ErrParse", err)
+	}
+	func() {
+		defer expectPanic(t, true)()
+		goenv.MustGetEnvIntWith("ENV_INT_WITH", validator)
+	}()
+	func() {
+		defer expectPanic(t, false)()
+		goenv.MustGetEnvIntWith("ENV_INT_WITH")
+	}()
+}
+
+func TestGetEnvDurationWith(t *testing.T) {
+	inRange := goenv.WithRange(time.Second, time.Minute)
+
+	t.Setenv("ENV_DURATION_WITH", "30s")
+	if got := goenv.GetEnvDurationWith("ENV_DURATION_WITH", time.Second, inRange); got != 30*time.Second {
+		t.Errorf("GetEnvDurationWith() = %v, want 30s", got)
+	}
+
+	t.Setenv("ENV_DURATION_WITH", "2m")
+	if got := goenv.GetEnvDurationWith("ENV_DURATION_WITH", time.Second, inRange); got != time.Second {
+		t.Errorf("GetEnvDurationWith() = %v, want fallback 1s", got)
+	}
+	if _, err := goenv.TryGetEnvDurationWith("ENV_DURATION_WITH", inRange); err == nil || !strings.Contains(err.Error(), "value 2m0s out of range [1s, 1m0s]") {
+		t.Errorf("TryGetEnvDurationWith() error = %v", err)
+	}
+	func() {
+		defer expectPanic(t, true)()
+		goenv.MustGetEnvDurationWith("ENV_DURATION_WITH", inRange)
+	}()
+}
@@ -0,0 +1,66 @@
+package goenv
+
+import (
+	"strconv"
+	"strings"
+)
+
+// TryGetEnvIntPair returns the two integers of the environment variable named by key,
+// which must be in "x,y" form, e.g. "10,20". Surrounding whitespace is ignored.
+// It returns an error if the variable is unset, empty, does not contain exactly two
+// comma-separated values, or either value cannot be parsed as int.
+func TryGetEnvIntPair(key string) (x, y int, err error) {
+	a, b, err := tryGetEnvPair(key, "[2]int")
+	if err != nil {
+		return 0, 0, err
+	}
+
+	x, err = strconv.Atoi(a)
+	if err != nil {
+		return 0, 0, parseErrorf(key, "unable to convert %q to an integer", a)
+	}
+	y, err = strconv.Atoi(b)
+	if err != nil {
+		return 0, 0, parseErrorf(key, "unable to convert %q to an integer", b)
+	}
+	return x, y, nil
+}
+
+// TryGetEnvFloat64Pair returns the two floats of the environment variable named by key,
+// which must be in "low,high" form, e.g. "0.1,0.9". Surrounding whitespace is ignored.
+// It returns an error if the variable is unset, empty, does not contain exactly two
+// comma-separated values, either value cannot be parsed as float64, or low > high.
+func TryGetEnvFloat64Pair(key string) (low, high float64, err error) {
+	a, b, err := tryGetEnvPair(key, "[2]float64")
+	if err != nil {
+		return 0, 0, err
+	}
+
+	low, err = strconv.ParseFloat(a, 64)
+	if err != nil {
+		return 0, 0, parseErrorf(key, "unable to convert %q to float64: %w", a, err)
+	}
+	high, err = strconv.ParseFloat(b, 64)
+	if err != nil {
+		return 0, 0, parseErrorf(key, "unable to convert %q to float64: %w", b, err)
+	}
+	if low > high {
+		return 0, 0, parseErrorf(key, "low value %v is greater than high value %v", low, high)
+	}
+	return low, high, nil
+}
+
+// tryGetEnvPair splits the value of key on a single comma into exactly two
+// trimmed parts, recording the read as a lookup of typ.
+func tryGetEnvPair(key, typ string) (string, string, error) {
+	v, err := tryGetEnv(key, typ)
+	if err != nil {
+		return "", "", err
+	}
+
+	parts := strings.Split(v, ",")
+	if len(parts) != 2 {
+		return "", "", parseErrorf(key, "expected 2 comma-separated values in %q, got %d", v, len(parts))
+	}
+	return strings.TrimSpace(parts[0]), strings.TrimSpace(parts[1]), nil
+}
@@ -0,0 +1,79 @@
+package goenv_test
+
+import (
+	"testing"
+
+	"github.com/battlej07/goenv"
+)
+
+/* ---------- int pair ---------- */
+
+func TestTryGetEnvIntPair(t *testing.T) {
+	tests := []struct {
+		name    string
+		key     string
+		set     bool
+		value   string
+		wantX   int
+		wantY   int
+		wantErr bool
+	}{
+		{name: "ok", key: "TRY_INT_PAIR", set: true, value: "10,20", wantX: 10, wantY: 20},
+		{name: "ok with spaces", key: "TRY_INT_PAIR", set: true, value: " -3 , 4 ", wantX: -3, wantY: 4},
+		{name: "too few -> err", key: "TRY_INT_PAIR", set: true, value: "10", wantErr: true},
+		{name: "too many -> err", key: "TRY_INT_PAIR", set: true, value: "1,2,3", wantErr: true},
+		{name: "bad ints -> err", key: "TRY_INT_PAIR", set: true, value: "a,b", wantErr: true},
+		{name: "empty -> err", key: "TRY_INT_PAIR", set: true, value: "", wantErr: true},
+		{name: "missing -> err", key: "TRY_INT_PAIR", set: false, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.set {
+				t.Setenv(tt.key, tt.value)
+			}
+			x, y, err := goenv.TryGetEnvIntPair(tt.key)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("TryGetEnvIntPair() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && (x != tt.wantX || y != tt.wantY) {
+				t.Errorf("TryGetEnvIntPair() = (%v, %v), want (%v, %v)", x, y, tt.wantX, tt.wantY)
+			}
+		})
+	}
+}
+
+/* ---------- float64 pair ---------- */
+
+func TestTryGetEnvFloat64Pair(t *testing.T) {
+	tests := []struct {
+		name     string
+		key      string
+		set      bool
+		value    string
+		wantLow  float64
+		wantHigh float64
+		wantErr  bool
+	}{
+		{name: "ok", key: "TRY_F64_PAIR", set: true, value: "0.1,0.9", wantLow: 0.1, wantHigh: 0.9},
+		{name: "ok equal", key: "TRY_F64_PAIR", set: true, value: "0.5, 0.5", wantLow: 0.5, wantHigh: 0.5},
+		{name: "reversed -> err", key: "TRY_F64_PAIR", set: true, value: "0.9,0.1", wantErr: true},
+		{name: "single value -> err", key: "TRY_F64_PAIR", set: true, value: "0.1", wantErr: true},
+		{name: "bad float -> err", key: "TRY_F64_PAIR", set: true, value: "0.1,x", wantErr: true},
+		{name: "empty -> err", key: "TRY_F64_PAIR", set: true, value: "", wantErr: true},
+		{name: "missing -> err", key: "TRY_F64_PAIR", set: false, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.set {
+				t.Setenv(tt.key, tt.value)
+			}
+			low, high, err := goenv.TryGetEnvFloat64Pair(tt.key)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("TryGetEnvFloat64Pair() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && (!almostEq64(low, tt.wantLow, 1e-12) || !almostEq64(high, tt.wantHigh, 1e-12)) {
+				t.Errorf("TryGetEnvFloat64Pair() = (%v, %v), want (%v, %v)", low, high, tt.wantLow, tt.wantHigh)
+			}
+		})
+	}
+}
@@ -0,0 +1,175 @@
+package goenv
+
+import "time"
+
+// Prefixed reads environment variables under a common prefix, e.g. PAYMENTS_ for a
+// payments service. Its methods mirror the package-level functions of the same name
+// and apply the prefix before lookup, so with prefix "PAYMENTS", p.GetEnvInt("RETRIES", 3)
+// reads PAYMENTS_RETRIES. The zero value applies no prefix.
+type Prefixed struct {
+	prefix string
+}
+
+// NewPrefixed returns a Prefixed that joins prefix and each key with an underscore.
+// An empty prefix leaves keys unchanged.
+func NewPrefixed(prefix string) Prefixed {
+	return Prefixed{prefix: prefix}
+}
+
+// Key returns the full environment variable name for key.
+func (p Prefixed) Key(key string) string {
+	if p.prefix == "" {
+		return key
+	}
+	return p.prefix + "_" + key
+}
+
+// GetEnv is GetEnv with p's prefix applied to key.
+func (p Prefixed) GetEnv(key string, fallback string) string {
+	return GetEnv(p.Key(key), fallback)
+}
+
+// TryGetEnv is TryGetEnv with p's prefix applied to key.
+func (p Prefixed) TryGetEnv(key string) (string, error) {
+	return TryGetEnv(p.Key(key))
+}
+
+// MustGetEnv is MustGetEnv with p's prefix applied to key.
+func (p Prefixed) MustGetEnv(key string) string {
+	return MustGetEnv(p.Key(key))
+}
+
+// GetEnvInt is GetEnvInt with p's prefix applied to key.
+func (p Prefixed) GetEnvInt(key string, fallback int) int {
+	return GetEnvInt(p.Key(key), fallback)
+}
+
+// TryGetEnvInt is TryGetEnvInt with p's prefix applied to key.
+func (p Prefixed) TryGetEnvInt(key string) (int, error) {
+	return TryGetEnvInt(p.Key(key))
+}
+
+// MustGetEnvInt is MustGetEnvInt with p's prefix applied to key.
+func (p Prefixed) MustGetEnvInt(key string) int {
+	return MustGetEnvInt(p.Key(key))
+}
+
+// GetEnvInt64 is GetEnvInt64 with p's prefix applied to key.
+func (p Prefixed) GetEnvInt64(key string, fallback int64) int64 {
+	return GetEnvInt64(p.Key(key), fallback)
+}
+
+// TryGetEnvInt64 is TryGetEnvInt64 with p's prefix applied to key.
+func (p Prefixed) TryGetEnvInt64(key string) (int64, error) {
+	return TryGetEnvInt64(p.Key(key))
+}
+
+// MustGetEnvInt64 is MustGetEnvInt64 with p's prefix applied to key.
+func (p Prefixed) MustGetEnvInt64(key string) int64 {
+	return MustGetEnvInt64(p.Key(key))
+}
+
+// GetEnvUint is GetEnvUint with p's prefix applied to key.
+func (p Prefixed) GetEnvUint(key string, fallback uint) uint {
+	return GetEnvUint(p.Key(key), fallback)
+}
+
+// TryGetEnvUint is TryGetEnvUint with p's prefix applied to key.
+func (p Prefixed) TryGetEnvUint(key string) (uint, error) {
+	return TryGetEnvUint(p.Key(key))
+}
+
+// MustGetEnvUint is MustGetEnvUint with p's prefix applied to key.
+func (p Prefixed) MustGetEnvUint(key string) uint {
+	return MustGetEnvUint(p.Key(key))
+}
+
+// GetEnvFloat32 is GetEnvFloat32 with p's prefix applied to key.
+func (p Prefixed) GetEnvFloat32(key string, fallback float32) float32 {
+	return GetEnvFloat32(p.Key(key), fallback)
+}
+
+// TryGetEnvFloat32 is TryGetEnvFloat32 with p's prefix applied to key.
+func (p Prefixed) TryGetEnvFloat32(key string) (float32, error) {
+	return TryGetEnvFloat32(p.Key(key))
+}
+
+// MustGetEnvFloat32 is MustGetEnvFloat32 with p's prefix applied to key.
+func (p Prefixed) MustGetEnvFloat32(key string) float32 {
+	return MustGetEnvFloat32(p.Key(key))
+}
+
+// GetEnvFloat64 is GetEnvFloat64 with p's prefix applied to key.
+func (p Prefixed) GetEnvFloat64(key string, fallback float64) float64 {
+	return GetEnvFloat64(p.Key(key), fallback)
+}
+
+// TryGetEnvFloat64 is TryGetEnvFloat64 with p's prefix applied to key.
+func (p Prefixed) TryGetEnvFloat64(key string) (float64, error) {
+	return TryGetEnvFloat64(p.Key(key))
+}
+
+// MustGetEnvFloat64 is MustGetEnvFloat64 with p's prefix applied to key.
+func (p Prefixed) MustGetEnvFloat64(key string) float64 {
+	return MustGetEnvFloat64(p.Key(key))
+}
+
+// GetEnvBool is GetEnvBool with p's prefix applied to key.
+func (p Prefixed) GetEnvBool(key string, fallback bool) bool {
+	return GetEnvBool(p.Key(key), fallback)
+}
+
+// TryGetEnvBool is TryGetEnvBool with p's prefix applied to key.
+func (p Prefixed) TryGetEnvBool(key string) (bool, error) {
+	return TryGetEnvBool(p.Key(key))
+}
+
+// MustGetEnvBool is MustGetEnvBool with p's prefix applied to key.
+func (p Prefixed) MustGetEnvBool(key string) bool {
+	return MustGetEnvBool(p.Key(key))
+}
+
+// GetEnvTime is GetEnvTime with p's prefix applied to key.
+func (p Prefixed) GetEnvTime(key string, fallback time.Time) time.Time {
+	return GetEnvTime(p.Key(key), fallback)
+}
+
+// TryGetEnvTime is TryGetEnvTime with p's prefix applied to key.
+func (p Prefixed) TryGetEnvTime(key string) (time.Time, error) {
+	return TryGetEnvTime(p.Key(key))
+}
+
+// MustGetEnvTime is MustGetEnvTime with p's prefix applied to key.
+func (p Prefixed) MustGetEnvTime(key string) time.Time {
+	return MustGetEnvTime(p.Key(key))
+}
+
+// GetEnvDuration is GetEnvDuration with p's prefix applied to key.
+func (p Prefixed) GetEnvDuration(key string, fallback time.Duration) time.Duration {
+	return GetEnvDuration(p.Key(key), fallback)
+}
+
+// TryGetEnvDuration is TryGetEnvDuration with p's prefix applied to key.
+func (p Prefixed) TryGetEnvDuration(key string) (time.Duration, error) {
+	return TryGetEnvDuration(p.Key(key))
+}
+
+// MustGetEnvDuration is MustGetEnvDuration with p's prefix applied to key.
+func (p Prefixed) MustGetEnvDuration(key string) time.Duration {
+	return MustGetEnvDuration(p.Key(key))
+}
+
+// GetEnvStringSlice is GetEnvStringSlice with p's prefix applied to key.
+func (p Prefixed) GetEnvStringSlice(key string, fallback []string) []string {
+	return GetEnvStringSlice(p.Key(key), fallback)
+}
+
+// TryGetEnvStringSlice is TryGetEnvStringSlice with p's prefix applied to key.
+func (p Prefixed) TryGetEnvStringSlice(key string) ([]string, error) {
+	return TryGetEnvStringSlice(p.Key(key))
+}
+
+// MustGetEnvStringSlice is MustGetEnvStringSlice with p's prefix applied to key.
+func (p Prefixed) MustGetEnvStringSlice(key string) []string {
+	return MustGetEnvStringSlice(p.Key(key))
+}
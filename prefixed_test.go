@@ -0,0 +1,62 @@
+package goenv_test
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/battlej07/goenv"
+)
+
+/* ---------- Prefixed ---------- */
+
+func TestPrefixed(t *testing.T) {
+	t.Setenv("PAYMENTS_RETRIES", "5")
+	t.Setenv("RETRIES", "1")
+	t.Setenv("PAYMENTS_TIMEOUT", "3s")
+	t.Setenv("PAYMENTS_HOSTS", "a, b")
+
+	payments := goenv.NewPrefixed("PAYMENTS")
+	plain := goenv.NewPrefixed("")
+
+	if got := payments.Key("RETRIES"); got != "PAYMENTS_RETRIES" {
+		t.Errorf("Key() = %q, want PAYMENTS_RETRIES", got)
+	}
+	if got := payments.GetEnvInt("RETRIES", 3); got != 5 {
+		t.Errorf("payments.GetEnvInt() = %d, want 5", got)
+	}
+	if got := plain.GetEnvInt("RETRIES", 3); got != 1 {
+		t.Errorf("plain.GetEnvInt() = %d, want 1", got)
+	}
+	if got := payments.GetEnv("MISSING", "fb"); got != "fb" {
+		t.Errorf("payments.GetEnv() = %q, want fallback", got)
+	}
+	if got, err := payments.TryGetEnvDuration("TIMEOUT"); err != nil || got != 3*time.Second {
+		t.Errorf("payments.TryGetEnvDuration() = %v, %v, want 3s", got, err)
+	}
+	if _, err := plain.TryGetEnvDuration("TIMEOUT"); err == nil {
+		t.Error("plain.TryGetEnvDuration() read the prefixed key")
+	}
+	if got := payments.GetEnvStringSlice("HOSTS", nil); !slices.Equal(got, []string{"a", "b"}) {
+		t.Errorf("payments.GetEnvStringSlice() = %q, want [a b]", got)
+	}
+
+	func() {
+		defer expectPanic(t, true)()
+		payments.MustGetEnvBool("DEBUG")
+	}()
+	func() {
+		defer expectPanic(t, false)()
+		if got := payments.MustGetEnvInt64("RETRIES"); got != 5 {
+			t.Errorf("payments.MustGetEnvInt64() = %d, want 5", got)
+		}
+	}()
+}
+
+func TestPrefixedZeroValue(t *testing.T) {
+	t.Setenv("PREFIXED_ZERO", "x")
+	var p goenv.Prefixed
+	if got := p.GetEnv("PREFIXED_ZERO", ""); got != "x" {
+		t.Errorf("GetEnv() = %q, want x", got)
+	}
+}
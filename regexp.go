@@ -0,0 +1,38 @@
+package goenv
+
+import (
+	"regexp"
+	"sync"
+)
+
+// regexpCache holds compiled patterns by source string for TryGetEnvRegexpSlice.
+// A *regexp.Regexp is safe for concurrent use, so cached values are shared.
+var regexpCache sync.Map // map[string]*regexp.Regexp
+
+// TryGetEnvRegexpSlice returns the comma-separated regular expressions in the
+// environment variable named by key, compiled with regexp.Compile, e.g. for allow-lists.
+// Compiled patterns are cached by source string for the life of the process, so
+// repeated calls return the same *regexp.Regexp values without recompiling. Patterns
+// that contain a comma cannot be expressed. It returns an error naming the first
+// invalid pattern, or if the variable is unset, empty, or contains no elements.
+func TryGetEnvRegexpSlice(key string) ([]*regexp.Regexp, error) {
+	elems, err := TryGetEnvStringSlice(key)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]*regexp.Regexp, len(elems))
+	for i, e := range elems {
+		if re, ok := regexpCache.Load(e); ok {
+			out[i] = re.(*regexp.Regexp)
+			continue
+		}
+		re, err := regexp.Compile(e)
+		if err != nil {
+			return nil, parseErrorf(key, "element %d %q is not a valid regexp: %w", i, e, err)
+		}
+		actual, _ := regexpCache.LoadOrStore(e, re)
+		out[i] = actual.(*regexp.Regexp)
+	}
+	return out, nil
+}
@@ -0,0 +1,47 @@
+package goenv_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/battlej07/goenv"
+)
+
+/* ---------- regexp slice ---------- */
+
+func TestTryGetEnvRegexpSlice(t *testing.T) {
+	t.Setenv("ENV_REGEXPS", `^api\.,  \.internal$ ,^v[0-9]+/`)
+
+	first, err := goenv.TryGetEnvRegexpSlice("ENV_REGEXPS")
+	if err != nil {
+		t.Fatalf("TryGetEnvRegexpSlice() failed: %v", err)
+	}
+	if len(first) != 3 {
+		t.Fatalf("TryGetEnvRegexpSlice() returned %d patterns, want 3", len(first))
+	}
+	if !first[0].MatchString("api.example") || !first[1].MatchString("db.internal") || first[2].MatchString("x/v1/") {
+		t.Errorf("TryGetEnvRegexpSlice() patterns match incorrectly: %v", first)
+	}
+
+	second, err := goenv.TryGetEnvRegexpSlice("ENV_REGEXPS")
+	if err != nil {
+		t.Fatalf("TryGetEnvRegexpSlice() failed: %v", err)
+	}
+	for i := range first {
+		if first[i] != second[i] {
+			t.Errorf("pattern %d recompiled, want cached *regexp.Regexp reused", i)
+		}
+	}
+}
+
+func TestTryGetEnvRegexpSliceErrors(t *testing.T) {
+	t.Setenv("ENV_REGEXPS", "ok,(unclosed")
+	_, err := goenv.TryGetEnvRegexpSlice("ENV_REGEXPS")
+	if err == nil || !strings.Contains(err.Error(), `element 1 "(unclosed" is not a valid regexp`) {
+		t.Errorf("TryGetEnvRegexpSlice() error = %v, want invalid element 1", err)
+	}
+
+	if _, err := goenv.TryGetEnvRegexpSlice("ENV_REGEXPS_MISSING"); err == nil {
+		t.Error("TryGetEnvRegexpSlice() succeeded for a missing variable")
+	}
+}
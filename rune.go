@@ -0,0 +1,56 @@
+package goenv
+
+import (
+	"strconv"
+	"unicode/utf8"
+)
+
+// GetEnvRune returns the single character in the environment variable named by key,
+// e.g. ";" or `\t` for a delimiter. If the variable is unset, empty, or not exactly
+// one character, it returns fallback.
+func GetEnvRune(key string, fallback rune) rune {
+	v, err := TryGetEnvRune(key)
+	return orFallback(key, v, err, fallback)
+}
+
+// TryGetEnvRune returns the single character in the environment variable named by key,
+// decoded as UTF-8, so a multibyte character such as "€" is one rune. A value starting
+// with a backslash is interpreted as a Go escape sequence, e.g. `\t`, `\n`, `\\` or
+// `\u20ac`; a lone backslash is the backslash itself. It returns an error if the
+// variable is unset, empty, not valid UTF-8, or holds more than one character.
+func TryGetEnvRune(key string) (rune, error) {
+	v, err := tryGetEnv(key, "rune")
+	if err != nil {
+		return 0, err
+	}
+
+	if len(v) > 1 && v[0] == '\\' {
+		r, _, tail, err := strconv.UnquoteChar(v, '\'')
+		if err != nil {
+			return 0, parseErrorf(key, "invalid escape sequence %q: %w", v, err)
+		}
+		if tail != "" {
+			return 0, parseErrorf(key, "value %q must be a single character", v)
+		}
+		return r, nil
+	}
+
+	r, size := utf8.DecodeRuneInString(v)
+	if r == utf8.RuneError && size == 1 {
+		return 0, parseErrorf(key, "value %q is not valid UTF-8", v)
+	}
+	if size != len(v) {
+		return 0, parseErrorf(key, "value %q must be a single character", v)
+	}
+	return r, nil
+}
+
+// MustGetEnvRune returns the single character in the environment variable named by key.
+// It panics if the variable is unset, empty, or not exactly one character.
+func MustGetEnvRune(key string) rune {
+	v, err := TryGetEnvRune(key)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
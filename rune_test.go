@@ -0,0 +1,74 @@
+package goenv_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/battlej07/goenv"
+)
+
+/* ---------- rune ---------- */
+
+func TestTryGetEnvRune(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		want    rune
+		wantErr string
+	}{
+		{name: "plain char", value: ";", want: ';'},
+		{name: "multibyte char", value: "€", want: '€'},
+		{name: "literal tab", value: "\t", want: '\t'},
+		{name: "escaped tab", value: `\t`, want: '\t'},
+		{name: "escaped newline", value: `\n`, want: '\n'},
+		{name: "escaped backslash", value: `\\`, want: '\\'},
+		{name: "unicode escape", value: `\u20ac`, want: '€'},
+		{name: "lone backslash", value: `\`, want: '\\'},
+		{name: "two chars", value: "ab", wantErr: "must be a single character"},
+		{name: "escape plus char", value: `\tx`, wantErr: "must be a single character"},
+		{name: "unknown escape", value: `\q`, wantErr: "invalid escape sequence"},
+		{name: "invalid UTF-8", value: "\xff", wantErr: "not valid UTF-8"},
+		{name: "empty", value: "", wantErr: "unable to find"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("CSV_DELIM", tt.value)
+			got, err := goenv.TryGetEnvRune("CSV_DELIM")
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("TryGetEnvRune() error = %v, want it to contain %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("TryGetEnvRune() failed: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("TryGetEnvRune() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetEnvRune(t *testing.T) {
+	t.Setenv("CSV_DELIM", "|")
+	if got := goenv.GetEnvRune("CSV_DELIM", ','); got != '|' {
+		t.Errorf("GetEnvRune() = %q, want '|'", got)
+	}
+	t.Setenv("CSV_DELIM", ";;")
+	if got := goenv.GetEnvRune("CSV_DELIM", ','); got != ',' {
+		t.Errorf("GetEnvRune() = %q, want fallback ','", got)
+	}
+	func() {
+		defer expectPanic(t, true)()
+		goenv.MustGetEnvRune("CSV_DELIM")
+	}()
+
+	t.Setenv("CSV_DELIM", `\t`)
+	func() {
+		defer expectPanic(t, false)()
+		if got := goenv.MustGetEnvRune("CSV_DELIM"); got != '\t' {
+			t.Errorf("MustGetEnvRune() = %q, want tab", got)
+		}
+	}()
+}
@@ -0,0 +1,989 @@
+package goenv
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// keepTrailingSep is the inverse of the SetSliceTrailingSepDrop setting, so that the
+// zero value drops trailing separators.
+var keepTrailingSep atomic.Bool
+
+// SetSliceTrailingSepDrop controls whether a single trailing separator is ignored by
+// the slice getters that keep empty elements, namely GetEnvStringSlicePolicy with
+// keepEmpty, GetEnvStringSliceOpts without DropEmpty, and GetEnvStringSliceNoTrim.
+// When drop is true, the default, "a,b," yields ["a", "b"]; when false, it yields
+// ["a", "b", ""]. Getters that drop empty elements are unaffected. The setting is
+// global and safe for concurrent use.
+func SetSliceTrailingSepDrop(drop bool) {
+	keepTrailingSep.Store(!drop)
+}
+
+// GetEnvStringSlice returns the comma-separated elements of the environment variable
+// named by key. Elements are trimmed of surrounding whitespace and empty elements, such
+// as those left by a trailing comma, are dropped. If the variable is unset, empty, or
+// contains no elements, it returns fallback.
+func GetEnvStringSlice(key string, fallback []string) []string {
+	return GetEnvStringSliceSep(key, ",", fallback)
+}
+
+// TryGetEnvStringSlice returns the comma-separated elements of the environment variable
+// named by key, trimmed and with empty elements dropped. It returns an error if the
+// variable is unset, empty, or contains no elements.
+func TryGetEnvStringSlice(key string) ([]string, error) {
+	return TryGetEnvStringSliceSep(key, ",")
+}
+
+// MustGetEnvStringSlice returns the comma-separated elements of the environment variable
+// named by key, trimmed and with empty elements dropped. It panics if the variable is
+// unset, empty, or contains no elements.
+func MustGetEnvStringSlice(key string) []string {
+	v, err := TryGetEnvStringSlice(key)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// GetEnvStringSliceSep returns the elements of the environment variable named by key,
+// split on sep, e.g. ":" for PATH-style lists. Elements are trimmed and empty elements,
+// such as those between consecutive separators, are dropped. If sep is empty or the
+// variable is unset, empty, or contains no elements, it returns fallback.
+func GetEnvStringSliceSep(key, sep string, fallback []string) []string {
+	v, err := TryGetEnvStringSliceSep(key, sep)
+	return orFallback(key, v, err, fallback)
+}
+
+// TryGetEnvStringSliceSep returns the elements of the environment variable named by key,
+// split on sep, trimmed and with empty elements dropped. It returns an error if sep is
+// empty, rather than splitting into runes, or if the variable is unset, empty, or
+// contains no elements.
+func TryGetEnvStringSliceSep(key, sep string) ([]string, error) {
+	return tryGetEnvStringSliceSep(key, sep, "[]string")
+}
+
+// tryGetEnvStringSliceSep is TryGetEnvStringSliceSep recording the read as a lookup
+// of typ.
+func tryGetEnvStringSliceSep(key, sep, typ string) ([]string, error) {
+	if sep == "" {
+		return nil, parseErrorf(key, "separator must not be empty")
+	}
+	return tryGetEnvList(key, typ, func(v string) []string { return splitList(v, sep) })
+}
+
+// MustGetEnvStringSliceSep returns the elements of the environment variable named by
+// key, split on sep, trimmed and with empty elements dropped. It panics if sep is empty
+// or if the variable is unset, empty, or contains no elements.
+func MustGetEnvStringSliceSep(key, sep string) []string {
+	v, err := TryGetEnvStringSliceSep(key, sep)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// GetEnvIntSlice returns the comma-separated integers in the environment variable named
+// by key, e.g. "1,2,3,5,8". If the variable is unset, empty, contains no elements, or
+// any element cannot be parsed, it returns fallback.
+func GetEnvIntSlice(key string, fallback []int) []int {
+	return GetEnvSliceOr(key, ",", strconv.Atoi, fallback)
+}
+
+// TryGetEnvIntSlice returns the comma-separated integers in the environment variable
+// named by key. Elements are trimmed and empty elements are dropped before parsing.
+// It returns an error naming the first bad element and its index, or if the variable
+// is unset, empty, or contains no elements; it never returns a partial slice.
+func TryGetEnvIntSlice(key string) ([]int, error) {
+	return tryGetEnvSlice(key, ",", strconv.Atoi, "an integer")
+}
+
+// MustGetEnvIntSlice returns the comma-separated integers in the environment variable
+// named by key. It panics if the variable is unset, empty, contains no elements, or
+// any element cannot be parsed.
+func MustGetEnvIntSlice(key string) []int {
+	v, err := TryGetEnvIntSlice(key)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// GetEnvStringSliceAuto returns the elements of the environment variable named by key,
+// detecting the separator from the value itself. A comma takes precedence over a
+// semicolon, and a semicolon takes precedence over whitespace, so "a, b;c" splits on
+// commas only. Elements are trimmed and empty elements are dropped.
+// If the variable is unset, empty, or contains no elements, it returns fallback.
+func GetEnvStringSliceAuto(key string, fallback []string) []string {
+	v, err := tryGetEnvList(key, "[]string", splitAuto)
+	return orFallback(key, v, err, fallback)
+}
+
+// GetEnvStringSliceStrict returns the comma-separated elements of the environment
+// variable named by key, trimmed and with empty elements dropped. Unlike the other
+// slice getters, a value that contains no elements, such as ",,", yields an empty
+// non-nil slice; only an unset or empty variable returns fallback.
+func GetEnvStringSliceStrict(key string, fallback []string) []string {
+	v, err := tryGetEnv(key, "[]string")
+	return orFallback(key, splitList(v, ","), err, fallback)
+}
+
+// TryGetEnvDurationSliceSum returns the sum of the comma-separated durations in the
+// environment variable named by key, e.g. "1s,2s,3s" yields 6s. Each element must be a
+// valid time.ParseDuration string. It returns an error if the variable is unset, empty,
+// contains no elements, or any element cannot be parsed.
+func TryGetEnvDurationSliceSum(key string) (time.Duration, error) {
+	ds, err := tryGetEnvSlice(key, ",", time.ParseDuration, "a duration")
+	if err != nil {
+		return 0, err
+	}
+
+	var sum time.Duration
+	for _, d := range ds {
+		sum += d
+	}
+	return sum, nil
+}
+
+// GetEnvStringLines returns the lines of the environment variable named by key.
+// Each line is trimmed, and blank lines as well as lines starting with '#' are dropped,
+// which suits lists sourced from files with one entry per line.
+// If the variable is unset, empty, or contains no lines, it returns fallback.
+func GetEnvStringLines(key string, fallback []string) []string {
+	v, err := tryGetEnvList(key, "[]string", splitLines)
+	return orFallback(key, v, err, fallback)
+}
+
+// GetEnvStringSliceLazy returns a function that reads the comma-separated elements of
+// the environment variable named by key each time it is called, so values set after
+// wiring are still picked up. Elements are trimmed and empty elements are dropped.
+// If the variable is unset, empty, or contains no elements at call time, the function
+// returns fallback.
+func GetEnvStringSliceLazy(key string, fallback []string) func() []string {
+	return func() []string {
+		return GetEnvStringSlice(key, fallback)
+	}
+}
+
+// GetEnvStringSliceDeprecated returns the comma-separated elements of the environment
+// variable named by newKey. If newKey is unset, empty, or contains no elements, it
+// reads oldKey instead and calls warn with a deprecation message; a nil warn is ignored.
+// If neither variable provides elements, it returns fallback.
+func GetEnvStringSliceDeprecated(oldKey, newKey string, warn func(string), fallback []string) []string {
+	if v, err := TryGetEnvStringSlice(newKey); err == nil {
+		recordHit()
+		return v
+	}
+	if v, err := TryGetEnvStringSlice(oldKey); err == nil {
+		if warn != nil {
+			warn(fmt.Sprintf("env variable %s is deprecated, use %s instead", oldKey, newKey))
+		}
+		recordHit()
+		return v
+	}
+	recordFallback(newKey)
+	return fallback
+}
+
+// AppendEnvStringSlice appends the elements of the environment variable named by key,
+// split on sep, trimmed and with empty elements dropped, to dst and returns the
+// extended slice. Existing elements of dst are preserved and its spare capacity is
+// reused, which avoids allocating in hot paths. If sep is empty or the variable is
+// unset or empty, dst is returned unchanged.
+func AppendEnvStringSlice(dst []string, key, sep string) []string {
+	v, err := tryGetEnv(key, "[]string")
+	if err != nil || sep == "" {
+		return dst
+	}
+	for _, p := range strings.Split(v, sep) {
+		if p = strings.TrimSpace(p); p != "" {
+			dst = append(dst, p)
+		}
+	}
+	return dst
+}
+
+// GetEnvStringSlicePolicy returns the comma-separated elements of the environment
+// variable named by key, trimmed. If keepEmpty is true, empty elements are preserved,
+// so "a,,b" yields ["a", "", "b"]; otherwise they are dropped like in the other slice
+// getters. If the variable is unset, empty, or contains only empty elements, it
+// returns fallback.
+func GetEnvStringSlicePolicy(key string, keepEmpty bool, fallback []string) []string {
+	split := splitCommas
+	if keepEmpty {
+		split = func(v string) []string { return splitListKeepEmpty(v, ",") }
+	}
+	v, err := tryGetEnvList(key, "[]string", split)
+	return orFallback(key, v, err, fallback)
+}
+
+// SliceOpts configures how GetEnvStringSliceOpts turns a raw value into a list.
+// The zero value splits on commas and leaves elements untouched.
+type SliceOpts struct {
+	// Sep is the element separator. An empty Sep means ",".
+	Sep string
+	// TrimCutset lists the characters stripped from both ends of every element,
+	// e.g. " \t" for whitespace. An empty TrimCutset disables trimming.
+	TrimCutset string
+	// Lower converts every element to lower case.
+	Lower bool
+	// Dedup removes repeated elements, keeping the first occurrence.
+	Dedup bool
+	// DropEmpty removes elements that are empty.
+	DropEmpty bool
+}
+
+// GetEnvStringSliceOpts returns the elements of the environment variable named by key,
+// normalized according to opts. The options are applied in a fixed order: split on
+// Sep, trim TrimCutset, lower-case, drop empty elements, then deduplicate, so "A, a"
+// with TrimCutset " ", Lower and Dedup yields ["a"].
+// If the variable is unset, empty, or yields only empty elements, it returns fallback.
+func GetEnvStringSliceOpts(key string, opts SliceOpts, fallback []string) []string {
+	v, err := tryGetEnvList(key, "[]string", opts.split)
+	return orFallback(key, v, err, fallback)
+}
+
+// GetEnvStringSliceNoTrim returns the comma-separated elements of the environment
+// variable named by key exactly as written: surrounding whitespace is preserved and
+// empty elements are kept, so " a, b ,," yields [" a", " b ", ""] with the default
+// trailing separator setting. It is GetEnvStringSliceOpts with the zero SliceOpts.
+// If the variable is unset, empty, or yields only empty elements, it returns fallback.
+func GetEnvStringSliceNoTrim(key string, fallback []string) []string {
+	return GetEnvStringSliceOpts(key, SliceOpts{}, fallback)
+}
+
+// split applies opts to the raw value v.
+func (opts SliceOpts) split(v string) []string {
+	sep := opts.Sep
+	if sep == "" {
+		sep = ","
+	}
+
+	parts := strings.Split(dropTrailingSep(v, sep), sep)
+	out := make([]string, 0, len(parts))
+	seen := make(map[string]struct{}, len(parts))
+	for _, p := range parts {
+		if opts.TrimCutset != "" {
+			p = strings.Trim(p, opts.TrimCutset)
+		}
+		if opts.Lower {
+			p = strings.ToLower(p)
+		}
+		if opts.DropEmpty && p == "" {
+			continue
+		}
+		if opts.Dedup {
+			if _, ok := seen[p]; ok {
+				continue
+			}
+			seen[p] = struct{}{}
+		}
+		out = append(out, p)
+	}
+	return out
+}
+
+// TryGetEnvStringSlicePositional returns the comma-separated elements of the environment
+// variable named by key, checking element i with validators[i]. A nil validator accepts
+// any value at its position. It returns an error if the variable is unset, empty,
+// contains no elements, the number of elements differs from the number of validators,
+// or any validator fails.
+func TryGetEnvStringSlicePositional(key string, validators ...func(string) error) ([]string, error) {
+	elems, err := TryGetEnvStringSlice(key)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(elems) != len(validators) {
+		return nil, parseErrorf(key, "expected %d elements, got %d", len(validators), len(elems))
+	}
+	for i, e := range elems {
+		if validators[i] == nil {
+			continue
+		}
+		if err := validators[i](e); err != nil {
+			return nil, parseErrorf(key, "element %d %q: %w", i, e, err)
+		}
+	}
+	return elems, nil
+}
+
+// GetEnvStringSliceFromKeys returns the comma-separated elements of every environment
+// variable named in keys, concatenated in key order. Repeated elements are dropped,
+// keeping the first occurrence, and keys that are unset, empty, or contain no elements
+// contribute nothing. If none of the keys provides elements, it returns fallback.
+func GetEnvStringSliceFromKeys(keys []string, fallback []string) []string {
+	var (
+		out   []string
+		found bool
+		first string
+		seen  = make(map[string]struct{})
+	)
+	for _, key := range keys {
+		elems, err := TryGetEnvStringSlice(key)
+		if err != nil {
+			continue
+		}
+		if !found {
+			first = key
+		}
+		found = true
+		for _, e := range elems {
+			if _, ok := seen[e]; ok {
+				continue
+			}
+			seen[e] = struct{}{}
+			out = append(out, e)
+		}
+	}
+	if !found {
+		if len(keys) > 0 {
+			first = keys[0]
+		}
+		recordFallback(first)
+		return fallback
+	}
+	recordHit()
+	return out
+}
+
+// TryGetEnvIntSliceAll returns the comma-separated integers of the environment variable
+// named by key, parsing every element instead of stopping at the first failure.
+// All parse failures are joined into the returned error with errors.Join, and the
+// returned slice still holds the parsed value at every valid position, with 0 at the
+// invalid ones. It returns a nil slice and an error if the variable is unset, empty,
+// or contains no elements.
+func TryGetEnvIntSliceAll(key string) ([]int, error) {
+	elems, err := tryGetEnvStringSliceSep(key, ",", "[]int")
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]int, len(elems))
+	var errs []error
+	for i, e := range elems {
+		n, err := strconv.Atoi(e)
+		if err != nil {
+			errs = append(errs, parseErrorf(key, "element %d %q is not an integer", i, e))
+			continue
+		}
+		out[i] = n
+	}
+	return out, errors.Join(errs...)
+}
+
+// GetEnvStringSliceTimed returns the comma-separated elements of the environment
+// variable named by key together with the wall-clock time spent reading and parsing
+// them, which helps profile configuration loading for very large lists.
+// If the variable is unset or empty, it returns fallback.
+func GetEnvStringSliceTimed(key string, fallback []string) (list []string, parse time.Duration) {
+	start := time.Now()
+	list = GetEnvStringSlice(key, fallback)
+	return list, time.Since(start)
+}
+
+// GetEnvStringSliceNormalized returns the comma-separated elements of the environment
+// variable named by key with normalize applied to each element, e.g. a Unicode NFC
+// normalizer supplied by the caller so the package stays free of dependencies.
+// A nil normalize leaves elements unchanged. The fallback is returned as is, without
+// normalization, if the variable is unset, empty, or contains no elements.
+func GetEnvStringSliceNormalized(key string, normalize func(string) string, fallback []string) []string {
+	elems, err := TryGetEnvStringSlice(key)
+	if err != nil {
+		recordFallback(key)
+		return fallback
+	}
+	recordHit()
+
+	if normalize != nil {
+		for i, e := range elems {
+			elems[i] = normalize(e)
+		}
+	}
+	return elems
+}
+
+// TryGetEnvWeightedSlice returns the names and weights of the environment variable named
+// by key, given as comma-separated name:weight entries, e.g. "a:5,b:3,c:2".
+// Names and weights are returned in entry order and weights must be positive integers.
+// It returns an error if the variable is unset, empty, contains no entries, or an
+// entry has no name, no weight, or a weight that is not a positive integer.
+func TryGetEnvWeightedSlice(key string) (names []string, weights []int, err error) {
+	elems, err := TryGetEnvStringSlice(key)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	names = make([]string, 0, len(elems))
+	weights = make([]int, 0, len(elems))
+	for i, e := range elems {
+		name, w, ok := strings.Cut(e, ":")
+		name = strings.TrimSpace(name)
+		if !ok || name == "" {
+			return nil, nil, parseErrorf(key, "element %d %q is not a name:weight entry", i, e)
+		}
+		n, err := strconv.Atoi(strings.TrimSpace(w))
+		if err != nil || n <= 0 {
+			return nil, nil, parseErrorf(key, "element %d %q has invalid weight %q, want a positive integer", i, e, w)
+		}
+		names = append(names, name)
+		weights = append(weights, n)
+	}
+	return names, weights, nil
+}
+
+// GetEnvStringSliceReport returns the comma-separated elements of the environment
+// variable named by key and whether they came from the environment. If the variable
+// is unset, empty, or contains no elements, it returns fallback and false.
+func GetEnvStringSliceReport(key string, fallback []string) (list []string, fromEnv bool) {
+	v, err := TryGetEnvStringSlice(key)
+	return orFallback(key, v, err, fallback), err == nil
+}
+
+// TryGetEnvStringSliceDeadline returns the elements of the environment variable named by
+// key, split on sep, trimmed and with empty elements dropped, giving up if parsing takes
+// longer than deadline. This guards against pathologically large values. A non-positive
+// deadline disables the guard. It returns an error wrapping context.DeadlineExceeded on
+// timeout, and an error if sep is empty or the variable is unset, empty, or contains
+// no elements.
+func TryGetEnvStringSliceDeadline(key string, sep string, deadline time.Duration) ([]string, error) {
+	if sep == "" {
+		return nil, parseErrorf(key, "separator must not be empty")
+	}
+	v, err := tryGetEnv(key, "[]string")
+	if err != nil {
+		return nil, err
+	}
+
+	start := time.Now()
+	var out []string
+	for rest, more := v, true; more; {
+		if deadline > 0 && time.Since(start) > deadline {
+			return nil, parseErrorf(key, "parsing env variable with key %s after %d elements: %w", key, len(out), context.DeadlineExceeded)
+		}
+		var e string
+		e, rest, more = strings.Cut(rest, sep)
+		if e = strings.TrimSpace(e); e != "" {
+			out = append(out, e)
+		}
+	}
+	if len(out) == 0 {
+		return nil, noElementsError(key)
+	}
+	return out, nil
+}
+
+// GetEnvStringSlicePipeline returns the comma-separated elements of the environment
+// variable named by key after passing them through each step in order, so callers can
+// compose filtering, mapping, and sorting freely. Nil steps are skipped. If the variable
+// is unset, empty, or contains no elements, it returns fallback without running the steps.
+func GetEnvStringSlicePipeline(key string, fallback []string, steps ...func([]string) []string) []string {
+	elems, err := TryGetEnvStringSlice(key)
+	if err != nil {
+		recordFallback(key)
+		return fallback
+	}
+	recordHit()
+
+	for _, step := range steps {
+		if step != nil {
+			elems = step(elems)
+		}
+	}
+	return elems
+}
+
+// TryGetEnvStringSliceMinDistinct returns the distinct comma-separated elements of the
+// environment variable named by key, in first-seen order. It returns an error if fewer
+// than minDistinct distinct elements are present, even when the raw list is longer,
+// or if the variable is unset, empty, or contains no elements.
+func TryGetEnvStringSliceMinDistinct(key string, minDistinct int) ([]string, error) {
+	elems, err := TryGetEnvStringSlice(key)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[string]struct{}, len(elems))
+	out := elems[:0]
+	for _, e := range elems {
+		if _, ok := seen[e]; !ok {
+			seen[e] = struct{}{}
+			out = append(out, e)
+		}
+	}
+	if len(out) < minDistinct {
+		return nil, parseErrorf(key, "got %d distinct elements, want at least %d", len(out), minDistinct)
+	}
+	return out, nil
+}
+
+// TryGetEnvStringSliceValidateAll returns the comma-separated elements of the environment
+// variable named by key after checking the list as a whole with validate, for rules that
+// span elements such as weights summing to 100. A nil validate accepts any list.
+// It returns validate's error, or an error if the variable is unset, empty, or contains
+// no elements, in which case validate is not called.
+func TryGetEnvStringSliceValidateAll(key string, validate func([]string) error) ([]string, error) {
+	elems, err := TryGetEnvStringSlice(key)
+	if err != nil {
+		return nil, err
+	}
+
+	if validate != nil {
+		if err := validate(elems); err != nil {
+			return nil, parseErrorf(key, "invalid list in env variable with key %s: %w", key, err)
+		}
+	}
+	return elems, nil
+}
+
+// TryGetEnvStringSliceRequiredIf returns the comma-separated elements of the environment
+// variable named by key, requiring them only when the bool at conditionKey is true, e.g.
+// TLS_HOSTS only when TLS_ENABLED=true. An unset or empty conditionKey counts as false. When the
+// list is not required and missing, it returns an empty slice and no error.
+// It returns an error if the list is required but unset, empty, or contains no
+// elements, or if conditionKey is set to a value that is not a bool.
+func TryGetEnvStringSliceRequiredIf(key, conditionKey string) ([]string, error) {
+	required, err := TryGetEnvBool(conditionKey)
+	if err != nil && os.Getenv(conditionKey) != "" {
+		return nil, fmt.Errorf("condition %s: %w", conditionKey, err)
+	}
+
+	elems, err := TryGetEnvStringSlice(key)
+	if err != nil {
+		if required {
+			return nil, fmt.Errorf("env variable with key %s is required when %s is true: %w", key, conditionKey, err)
+		}
+		return []string{}, nil
+	}
+	return elems, nil
+}
+
+// TryGetEnvStringSliceOrdered returns the comma-separated elements of the environment
+// variable named by key, requiring them to follow the relative order of canonical.
+// The list may omit canonical elements, so with canonical ["build", "test", "deploy"]
+// the value "build,deploy" is accepted while "deploy,build" is not. It returns an error
+// naming the first element out of order or not in canonical, or if the variable is
+// unset, empty, or contains no elements.
+func TryGetEnvStringSliceOrdered(key string, canonical []string) ([]string, error) {
+	elems, err := TryGetEnvStringSlice(key)
+	if err != nil {
+		return nil, err
+	}
+
+	rank := make(map[string]int, len(canonical))
+	for i, c := range canonical {
+		if _, ok := rank[c]; !ok {
+			rank[c] = i
+		}
+	}
+
+	last := -1
+	for i, e := range elems {
+		r, ok := rank[e]
+		if !ok {
+			return nil, parseErrorf(key, "element %d %q is not in the canonical order", i, e)
+		}
+		if r < last {
+			return nil, parseErrorf(key, "element %d %q must come before %q", i, e, canonical[last])
+		}
+		last = r
+	}
+	return elems, nil
+}
+
+// TryGetEnvTypedValues returns the comma-separated "value:type" entries in the
+// environment variable named by key, each converted to the annotated type, e.g.
+// "5:int,2.5:float,true:bool,x:string" yields []any{5, 2.5, true, "x"}. The supported
+// tags are int, float (float64), bool and string. The value is split from its tag at
+// the last colon, so string values may themselves contain colons. It returns an error
+// for a missing or unknown tag, a value that does not parse as its tag, or if the
+// variable is unset, empty, or contains no elements.
+func TryGetEnvTypedValues(key string) ([]any, error) {
+	elems, err := TryGetEnvStringSlice(key)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]any, len(elems))
+	for i, e := range elems {
+		sep := strings.LastIndex(e, ":")
+		if sep < 0 {
+			return nil, parseErrorf(key, "element %d %q has no type annotation, expected value:type", i, e)
+		}
+		v, typ := strings.TrimSpace(e[:sep]), strings.TrimSpace(e[sep+1:])
+		switch typ {
+		case "int":
+			out[i], err = strconv.Atoi(v)
+		case "float":
+			out[i], err = strconv.ParseFloat(v, 64)
+		case "bool":
+			out[i], err = strconv.ParseBool(v)
+		case "string":
+			out[i] = v
+		default:
+			return nil, parseErrorf(key, "element %d %q has unknown type %q", i, e, typ)
+		}
+		if err != nil {
+			return nil, parseErrorf(key, "element %d %q is not a valid %s: %w", i, e, typ, err)
+		}
+	}
+	return out, nil
+}
+
+// TryGetEnvStringSliceValidatedFallback returns the comma-separated elements of the
+// environment variable named by key, or fallback if the variable is unset, empty, or
+// contains no elements, after checking every element of the result with validate.
+// Validating the fallback as well catches bad defaults, e.g. in tests. It returns an
+// error naming the first invalid element and whether it came from the fallback.
+// A nil validate accepts every element.
+func TryGetEnvStringSliceValidatedFallback(key string, validate func(string) error, fallback []string) ([]string, error) {
+	list, fromEnv := GetEnvStringSliceReport(key, fallback)
+	if validate == nil {
+		return list, nil
+	}
+	for i, e := range list {
+		if err := validate(e); err != nil {
+			if !fromEnv {
+				return nil, parseErrorf(key, "fallback element %d %q: %w", i, e, err)
+			}
+			return nil, parseErrorf(key, "element %d %q: %w", i, e, err)
+		}
+	}
+	return list, nil
+}
+
+// TryGetEnvStringSliceResolveRefs returns the comma-separated elements of the
+// environment variable named by key with "@name" references replaced by the value of
+// an earlier element. A plain element such as "a" defines the name a with value "a";
+// a colon-keyed element such as "db:postgres" defines db with value "postgres", and its
+// value may itself be a reference, as in "replica:@db". So "a,db:postgres,@a,@db"
+// yields ["a", "db:postgres", "a", "postgres"]. Only earlier elements can be
+// referenced. It returns an error for an unknown or forward reference, an element that
+// references its own name, or if the variable is unset, empty, or contains no elements.
+func TryGetEnvStringSliceResolveRefs(key string) ([]string, error) {
+	elems, err := TryGetEnvStringSlice(key)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make(map[string]string, len(elems))
+	resolve := func(i int, tok, self string) (string, error) {
+		ref, ok := strings.CutPrefix(tok, "@")
+		if !ok {
+			return tok, nil
+		}
+		if ref == self {
+			return "", parseErrorf(key, "element %d %q: cyclic reference to %q", i, elems[i], ref)
+		}
+		v, ok := values[ref]
+		if !ok {
+			return "", parseErrorf(key, "element %d %q: unknown reference %q", i, elems[i], ref)
+		}
+		return v, nil
+	}
+
+	out := make([]string, len(elems))
+	for i, e := range elems {
+		name, val, keyed := strings.Cut(e, ":")
+		if !keyed {
+			v, err := resolve(i, e, "")
+			if err != nil {
+				return nil, err
+			}
+			out[i] = v
+			if !strings.HasPrefix(e, "@") {
+				values[e] = e
+			}
+			continue
+		}
+
+		v, err := resolve(i, val, name)
+		if err != nil {
+			return nil, err
+		}
+		out[i] = name + ":" + v
+		values[name] = v
+	}
+	return out, nil
+}
+
+// GetEnvStringSliceVersioned returns the comma-separated elements of the environment
+// variable named by v2Key. If v2Key is unset, empty, or contains no elements, it reads
+// v1Key instead and returns migrate applied to that list, e.g. to rename entries from
+// an older format; a nil migrate returns the v1 list as is. If neither variable
+// provides elements, it returns fallback without calling migrate.
+func GetEnvStringSliceVersioned(v2Key, v1Key string, migrate func([]string) []string, fallback []string) []string {
+	if v, err := TryGetEnvStringSlice(v2Key); err == nil {
+		recordHit()
+		return v
+	}
+	if v, err := TryGetEnvStringSlice(v1Key); err == nil {
+		recordHit()
+		if migrate != nil {
+			v = migrate(v)
+		}
+		return v
+	}
+	recordFallback(v2Key)
+	return fallback
+}
+
+// TryGetEnvStringSlicesBudget returns the comma-separated elements of each environment
+// variable named in keys, keyed by variable name, while bounding their combined size.
+// The raw byte length of every value added to the map counts towards maxTotalBytes,
+// and it returns an error naming the key that pushed the total over the budget. Keys
+// that are unset, empty, or contain no elements are left out of the map and cost
+// nothing. Each key is read once.
+func TryGetEnvStringSlicesBudget(keys []string, maxTotalBytes int) (map[string][]string, error) {
+	out := make(map[string][]string, len(keys))
+	total := 0
+	for _, key := range keys {
+		v, err := tryGetEnv(key, "[]string")
+		if err != nil {
+			continue
+		}
+		elems := splitList(v, ",")
+		if len(elems) == 0 {
+			continue
+		}
+		total += len(v)
+		if total > maxTotalBytes {
+			return nil, parseErrorf(key, "env variable with key %s exceeds byte budget: %d bytes total, limit %d", key, total, maxTotalBytes)
+		}
+		out[key] = elems
+	}
+	return out, nil
+}
+
+// GetEnvStringSliceConditional returns the comma-separated elements of the environment
+// variable named by key, with transform applied to each element only when the bool
+// variable named by flagKey is true, e.g. uppercasing tags when UPPERCASE_TAGS=true.
+// An unset, empty, or unparseable flag counts as false, and a nil transform is a no-op.
+// The fallback is returned as is, without transformation, if the variable is unset,
+// empty, or contains no elements.
+func GetEnvStringSliceConditional(key, flagKey string, transform func(string) string, fallback []string) []string {
+	elems, err := TryGetEnvStringSlice(key)
+	if err != nil {
+		recordFallback(key)
+		return fallback
+	}
+	recordHit()
+
+	if on, err := TryGetEnvBool(flagKey); err == nil && on && transform != nil {
+		for i, e := range elems {
+			elems[i] = transform(e)
+		}
+	}
+	return elems
+}
+
+// GetEnvSliceOr returns the elements of the environment variable named by key, split on
+// sep, trimmed, with empty elements dropped, and converted with parse. Parsing is
+// atomic: if any element fails, the whole call returns fallback rather than a partial
+// slice. It also returns fallback if sep is empty or the variable is unset, empty, or
+// contains no elements. The typed slice helpers are built on the same implementation.
+func GetEnvSliceOr[T any](key string, sep string, parse func(string) (T, error), fallback []T) []T {
+	v, err := tryGetEnvSlice(key, sep, parse, "valid")
+	return orFallback(key, v, err, fallback)
+}
+
+// tryGetEnvSlice splits key's value on sep and converts every element with parse,
+// failing on the first bad element. what describes the expected element, e.g.
+// "an integer", and is used in the error message.
+func tryGetEnvSlice[T any](key, sep string, parse func(string) (T, error), what string) ([]T, error) {
+	elems, err := tryGetEnvStringSliceSep(key, sep, typeName[[]T]())
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]T, len(elems))
+	for i, e := range elems {
+		t, err := parse(e)
+		if err != nil {
+			return nil, parseErrorf(key, "element %d %q is not %s: %w", i, e, what, err)
+		}
+		out[i] = t
+	}
+	return out, nil
+}
+
+// GetEnvStringSliceEachHook returns the comma-separated elements of the environment
+// variable named by key, calling hook with each element's index and value, in order,
+// before returning, e.g. to trace configuration usage. The hook also sees the fallback
+// elements if the variable is unset, empty, or contains no elements. A nil hook is a
+// no-op.
+func GetEnvStringSliceEachHook(key string, fallback []string, hook func(index int, elem string)) []string {
+	list := GetEnvStringSlice(key, fallback)
+	if hook != nil {
+		for i, e := range list {
+			hook(i, e)
+		}
+	}
+	return list
+}
+
+// GetEnvStringSliceSortedFunc returns the comma-separated elements of the environment
+// variable named by key, stably sorted with less, e.g. a locale-aware collator supplied
+// by the caller. A nil less sorts byte-wise. The fallback is used if the variable is
+// unset, empty, or contains no elements, and is sorted on a copy so the caller's slice
+// is left untouched.
+func GetEnvStringSliceSortedFunc(key string, less func(a, b string) bool, fallback []string) []string {
+	list := slices.Clone(GetEnvStringSlice(key, fallback))
+	if less == nil {
+		less = func(a, b string) bool { return a < b }
+	}
+	sort.SliceStable(list, func(i, j int) bool { return less(list[i], list[j]) })
+	return list
+}
+
+// GetEnvStringSliceDedupReport returns the comma-separated elements of the environment
+// variable named by key with repeats removed, keeping the first occurrence of each, along
+// with every removed occurrence in encounter order, e.g. so the caller can log them.
+// The fallback is returned as is, with nil duplicates, if the variable is unset, empty,
+// or contains no elements.
+func GetEnvStringSliceDedupReport(key string, fallback []string) (unique []string, duplicates []string) {
+	elems, err := TryGetEnvStringSlice(key)
+	if err != nil {
+		recordFallback(key)
+		return fallback, nil
+	}
+	recordHit()
+
+	seen := make(map[string]struct{}, len(elems))
+	for _, e := range elems {
+		if _, ok := seen[e]; ok {
+			duplicates = append(duplicates, e)
+			continue
+		}
+		seen[e] = struct{}{}
+		unique = append(unique, e)
+	}
+	return unique, duplicates
+}
+
+// TryGetEnvSliceParallel is like GetEnvSliceOr but parses the elements concurrently
+// across workers goroutines, for long lists with expensive parse functions such as
+// regexp.Compile. The output keeps the input order. Every element is parsed, and the
+// error returned is the one for the lowest failing index, so results do not depend on
+// scheduling. workers below 1 means 1. It returns an error if sep is empty or the
+// variable is unset, empty, or contains no elements.
+func TryGetEnvSliceParallel[T any](key, sep string, parse func(string) (T, error), workers int) ([]T, error) {
+	elems, err := tryGetEnvStringSliceSep(key, sep, typeName[[]T]())
+	if err != nil {
+		return nil, err
+	}
+	workers = max(1, min(workers, len(elems)))
+
+	out := make([]T, len(elems))
+	errs := make([]error, len(elems))
+	next := make(chan int)
+	var wg sync.WaitGroup
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range next {
+				out[i], errs[i] = parse(elems[i])
+			}
+		}()
+	}
+	for i := range elems {
+		next <- i
+	}
+	close(next)
+	wg.Wait()
+
+	for i, err := range errs {
+		if err != nil {
+			return nil, parseErrorf(key, "element %d %q is not valid: %w", i, elems[i], err)
+		}
+	}
+	return out, nil
+}
+
+// tryGetEnvList reads key as a lookup of typ and splits its value with split. A
+// value that yields no non-empty element, such as ",,", is reported like an unset
+// variable so that fallback-returning getters use their fallback.
+func tryGetEnvList(key, typ string, split func(string) []string) ([]string, error) {
+	v, err := tryGetEnv(key, typ)
+	if err != nil {
+		return nil, err
+	}
+	elems := split(v)
+	if !slices.ContainsFunc(elems, func(e string) bool { return e != "" }) {
+		return nil, noElementsError(key)
+	}
+	return elems, nil
+}
+
+// splitAuto splits v on the separator detected by GetEnvStringSliceAuto.
+func splitAuto(v string) []string {
+	switch {
+	case strings.Contains(v, ","):
+		return splitList(v, ",")
+	case strings.Contains(v, ";"):
+		return splitList(v, ";")
+	default:
+		return strings.Fields(v)
+	}
+}
+
+// splitLines splits v into trimmed lines, dropping blank and '#'-comment lines.
+func splitLines(v string) []string {
+	lines := strings.Split(v, "\n")
+	out := make([]string, 0, len(lines))
+	for _, l := range lines {
+		l = strings.TrimSpace(l)
+		if l == "" || strings.HasPrefix(l, "#") {
+			continue
+		}
+		out = append(out, l)
+	}
+	return out
+}
+
+// splitListKeepEmpty splits v on sep and trims surrounding whitespace from each
+// element, keeping empty elements. A trailing separator is handled according to
+// SetSliceTrailingSepDrop.
+func splitListKeepEmpty(v, sep string) []string {
+	parts := strings.Split(dropTrailingSep(strings.TrimSpace(v), sep), sep)
+	for i, p := range parts {
+		parts[i] = strings.TrimSpace(p)
+	}
+	return parts
+}
+
+// dropTrailingSep removes a single trailing sep from v unless trailing separators
+// are kept by SetSliceTrailingSepDrop(false).
+func dropTrailingSep(v, sep string) string {
+	if keepTrailingSep.Load() {
+		return v
+	}
+	return strings.TrimSuffix(v, sep)
+}
+
+// splitCommas is splitList with a comma separator.
+func splitCommas(v string) []string {
+	return splitList(v, ",")
+}
+
+// splitList splits v on sep, trims surrounding whitespace from each element
+// and drops elements that end up empty.
+func splitList(v, sep string) []string {
+	parts := strings.Split(v, sep)
+	out := make([]string, 0, len(parts))
+	for _, p := range parts {
+		if p = strings.TrimSpace(p); p != "" {
+			out = append(out, p)
+		}
+	}
+	return out
+}
@@ -0,0 +1,1700 @@
+package goenv_test
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/battlej07/goenv"
+)
+
+/* ---------- string slice (auto separator) ---------- */
+
+func TestGetEnvStringSliceAuto(t *testing.T) {
+	tests := []struct {
+		name     string
+		key      string
+		set      bool
+		value    string
+		fallback []string
+		want     []string
+	}{
+		{name: "comma", key: "ENV_SLICE_AUTO", set: true, value: "a, b,c", fallback: []string{"x"}, want: []string{"a", "b", "c"}},
+		{name: "semicolon", key: "ENV_SLICE_AUTO", set: true, value: "a; b;c", fallback: []string{"x"}, want: []string{"a", "b", "c"}},
+		{name: "whitespace", key: "ENV_SLICE_AUTO", set: true, value: "a  b\tc", fallback: []string{"x"}, want: []string{"a", "b", "c"}},
+		{name: "comma beats semicolon", key: "ENV_SLICE_AUTO", set: true, value: "a;b,c", fallback: []string{"x"}, want: []string{"a;b", "c"}},
+		{name: "semicolon beats whitespace", key: "ENV_SLICE_AUTO", set: true, value: "a b;c", fallback: []string{"x"}, want: []string{"a b", "c"}},
+		{name: "empty -> fallback", key: "ENV_SLICE_AUTO", set: true, value: "", fallback: []string{"x"}, want: []string{"x"}},
+		{name: "missing -> fallback", key: "ENV_SLICE_AUTO", set: false, fallback: []string{"x"}, want: []string{"x"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.set {
+				t.Setenv(tt.key, tt.value)
+			}
+			if got := goenv.GetEnvStringSliceAuto(tt.key, tt.fallback); !slices.Equal(got, tt.want) {
+				t.Errorf("GetEnvStringSliceAuto() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+/* ---------- duration slice sum ---------- */
+
+func TestTryGetEnvDurationSliceSum(t *testing.T) {
+	tests := []struct {
+		name    string
+		key     string
+		set     bool
+		value   string
+		want    time.Duration
+		wantErr bool
+	}{
+		{name: "ok", key: "TRY_DUR_SUM", set: true, value: "1s,2s,3s", want: 6 * time.Second},
+		{name: "ok mixed units", key: "TRY_DUR_SUM", set: true, value: "1m, 30s", want: 90 * time.Second},
+		{name: "only separators -> err", key: "TRY_DUR_SUM", set: true, value: ", ,", wantErr: true},
+		{name: "empty -> err", key: "TRY_DUR_SUM", set: true, value: "", wantErr: true},
+		{name: "missing -> err", key: "TRY_DUR_SUM", set: false, wantErr: true},
+		{name: "bad element -> err", key: "TRY_DUR_SUM", set: true, value: "1s,oops,3s", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.set {
+				t.Setenv(tt.key, tt.value)
+			}
+			got, err := goenv.TryGetEnvDurationSliceSum(tt.key)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("TryGetEnvDurationSliceSum() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && got != tt.want {
+				t.Errorf("TryGetEnvDurationSliceSum() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+/* ---------- string lines ---------- */
+
+func TestGetEnvStringLines(t *testing.T) {
+	tests := []struct {
+		name     string
+		key      string
+		set      bool
+		value    string
+		fallback []string
+		want     []string
+	}{
+		{name: "single line", key: "ENV_LINES", set: true, value: "a", fallback: []string{"x"}, want: []string{"a"}},
+		{
+			name:     "comments and blanks",
+			key:      "ENV_LINES",
+			set:      true,
+			value:    "# hosts\nalpha\n\n  beta  \n\t# disabled\r\ngamma\r\n",
+			fallback: []string{"x"},
+			want:     []string{"alpha", "beta", "gamma"},
+		},
+		{name: "empty -> fallback", key: "ENV_LINES", set: true, value: "", fallback: []string{"x"}, want: []string{"x"}},
+		{name: "missing -> fallback", key: "ENV_LINES", set: false, fallback: []string{"x"}, want: []string{"x"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.set {
+				t.Setenv(tt.key, tt.value)
+			}
+			if got := goenv.GetEnvStringLines(tt.key, tt.fallback); !slices.Equal(got, tt.want) {
+				t.Errorf("GetEnvStringLines() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+/* ---------- lazy string slice ---------- */
+
+func TestGetEnvStringSliceLazy(t *testing.T) {
+	get := goenv.GetEnvStringSliceLazy("ENV_SLICE_LAZY", []string{"x"})
+
+	if got, want := get(), []string{"x"}; !slices.Equal(got, want) {
+		t.Errorf("before set = %q, want %q", got, want)
+	}
+
+	t.Setenv("ENV_SLICE_LAZY", "a, b")
+	if got, want := get(), []string{"a", "b"}; !slices.Equal(got, want) {
+		t.Errorf("after set = %q, want %q", got, want)
+	}
+
+	t.Setenv("ENV_SLICE_LAZY", "c")
+	if got, want := get(), []string{"c"}; !slices.Equal(got, want) {
+		t.Errorf("after change = %q, want %q", got, want)
+	}
+}
+
+/* ---------- deprecated string slice ---------- */
+
+func TestGetEnvStringSliceDeprecated(t *testing.T) {
+	tests := []struct {
+		name     string
+		oldValue string
+		newValue string
+		want     []string
+		wantWarn bool
+	}{
+		{name: "new key used", oldValue: "old", newValue: "a,b", want: []string{"a", "b"}},
+		{name: "old key used", oldValue: "c, d", want: []string{"c", "d"}, wantWarn: true},
+		{name: "neither -> fallback", want: []string{"x"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.oldValue != "" {
+				t.Setenv("ENV_SLICE_OLD", tt.oldValue)
+			}
+			if tt.newValue != "" {
+				t.Setenv("ENV_SLICE_NEW", tt.newValue)
+			}
+			var warnings []string
+			warn := func(msg string) { warnings = append(warnings, msg) }
+
+			got := goenv.GetEnvStringSliceDeprecated("ENV_SLICE_OLD", "ENV_SLICE_NEW", warn, []string{"x"})
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("GetEnvStringSliceDeprecated() = %q, want %q", got, tt.want)
+			}
+			if gotWarn := len(warnings) > 0; gotWarn != tt.wantWarn {
+				t.Errorf("warned = %v (%q), want %v", gotWarn, warnings, tt.wantWarn)
+			}
+		})
+	}
+
+	t.Run("nil warn", func(t *testing.T) {
+		t.Setenv("ENV_SLICE_OLD", "a")
+		if got, want := goenv.GetEnvStringSliceDeprecated("ENV_SLICE_OLD", "ENV_SLICE_NEW", nil, nil), []string{"a"}; !slices.Equal(got, want) {
+			t.Errorf("GetEnvStringSliceDeprecated() = %q, want %q", got, want)
+		}
+	})
+}
+
+/* ---------- append string slice ---------- */
+
+func TestAppendEnvStringSlice(t *testing.T) {
+	t.Run("appends and preserves dst", func(t *testing.T) {
+		t.Setenv("ENV_SLICE_APPEND", "b; c;;")
+
+		dst := make([]string, 1, 8)
+		dst[0] = "a"
+		got := goenv.AppendEnvStringSlice(dst, "ENV_SLICE_APPEND", ";")
+		if want := []string{"a", "b", "c"}; !slices.Equal(got, want) {
+			t.Errorf("AppendEnvStringSlice() = %q, want %q", got, want)
+		}
+		if &got[0] != &dst[0] {
+			t.Errorf("AppendEnvStringSlice() reallocated despite spare capacity")
+		}
+	})
+
+	t.Run("missing -> dst unchanged", func(t *testing.T) {
+		dst := []string{"a"}
+		got := goenv.AppendEnvStringSlice(dst, "ENV_SLICE_APPEND", ",")
+		if !slices.Equal(got, dst) {
+			t.Errorf("AppendEnvStringSlice() = %q, want %q", got, dst)
+		}
+	})
+
+	t.Run("nil dst", func(t *testing.T) {
+		t.Setenv("ENV_SLICE_APPEND", "x,y")
+		got := goenv.AppendEnvStringSlice(nil, "ENV_SLICE_APPEND", ",")
+		if want := []string{"x", "y"}; !slices.Equal(got, want) {
+			t.Errorf("AppendEnvStringSlice() = %q, want %q", got, want)
+		}
+	})
+	t.Run("empty sep -> dst unchanged", func(t *testing.T) {
+		t.Setenv("ENV_SLICE_APPEND", "xy")
+		dst := []string{"a"}
+		got := goenv.AppendEnvStringSlice(dst, "ENV_SLICE_APPEND", "")
+		if !slices.Equal(got, dst) {
+			t.Errorf("AppendEnvStringSlice() = %q, want %q", got, dst)
+		}
+	})
+}
+
+/* ---------- string slice (empty policy) ---------- */
+
+func TestGetEnvStringSlicePolicy(t *testing.T) {
+	tests := []struct {
+		name      string
+		key       string
+		set       bool
+		value     string
+		keepEmpty bool
+		fallback  []string
+		want      []string
+	}{
+		{name: "drop empty", key: "ENV_SLICE_POLICY", set: true, value: "a,,b", want: []string{"a", "b"}},
+		{name: "keep empty", key: "ENV_SLICE_POLICY", set: true, value: "a,,b", keepEmpty: true, want: []string{"a", "", "b"}},
+		{name: "keep empty trims", key: "ENV_SLICE_POLICY", set: true, value: " a , ,b ", keepEmpty: true, want: []string{"a", "", "b"}},
+		{name: "missing -> fallback", key: "ENV_SLICE_POLICY", set: false, keepEmpty: true, fallback: []string{"x"}, want: []string{"x"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.set {
+				t.Setenv(tt.key, tt.value)
+			}
+			if got := goenv.GetEnvStringSlicePolicy(tt.key, tt.keepEmpty, tt.fallback); !slices.Equal(got, tt.want) {
+				t.Errorf("GetEnvStringSlicePolicy() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+/* ---------- string slice (options) ---------- */
+
+func TestGetEnvStringSliceOpts(t *testing.T) {
+	tests := []struct {
+		name     string
+		set      bool
+		value    string
+		opts     goenv.SliceOpts
+		fallback []string
+		want     []string
+	}{
+		{name: "zero value splits only", set: true, value: " A,,b ", want: []string{" A", "", "b "}},
+		{name: "sep", set: true, value: "a;b", opts: goenv.SliceOpts{Sep: ";"}, want: []string{"a", "b"}},
+		{name: "trim cutset", set: true, value: " a ,-b-", opts: goenv.SliceOpts{TrimCutset: " -"}, want: []string{"a", "b"}},
+		{name: "lower", set: true, value: "A,Bc", opts: goenv.SliceOpts{Lower: true}, want: []string{"a", "bc"}},
+		{name: "dedup", set: true, value: "a,b,a", opts: goenv.SliceOpts{Dedup: true}, want: []string{"a", "b"}},
+		{name: "drop empty", set: true, value: "a,,b,", opts: goenv.SliceOpts{DropEmpty: true}, want: []string{"a", "b"}},
+		{
+			name:  "combined",
+			set:   true,
+			value: " A | a |  | B|b ",
+			opts:  goenv.SliceOpts{Sep: "|", TrimCutset: " ", Lower: true, Dedup: true, DropEmpty: true},
+			want:  []string{"a", "b"},
+		},
+		{name: "missing -> fallback", set: false, opts: goenv.SliceOpts{Lower: true}, fallback: []string{"X"}, want: []string{"X"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.set {
+				t.Setenv("ENV_SLICE_OPTS", tt.value)
+			}
+			if got := goenv.GetEnvStringSliceOpts("ENV_SLICE_OPTS", tt.opts, tt.fallback); !slices.Equal(got, tt.want) {
+				t.Errorf("GetEnvStringSliceOpts() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+/* ---------- positional string slice ---------- */
+
+func TestTryGetEnvStringSlicePositional(t *testing.T) {
+	noSpaces := func(s string) error {
+		if strings.Contains(s, " ") {
+			return errors.New("must not contain spaces")
+		}
+		return nil
+	}
+	isPort := func(s string) error {
+		_, err := strconv.Atoi(s)
+		return err
+	}
+
+	tests := []struct {
+		name    string
+		set     bool
+		value   string
+		want    []string
+		wantErr bool
+	}{
+		{name: "ok", set: true, value: "db.local, 5432, prod", want: []string{"db.local", "5432", "prod"}},
+		{name: "failing validator -> err", set: true, value: "db.local,port,prod", wantErr: true},
+		{name: "too few -> err", set: true, value: "db.local,5432", wantErr: true},
+		{name: "too many -> err", set: true, value: "db.local,5432,prod,extra", wantErr: true},
+		{name: "no elements -> err", set: true, value: ",,", wantErr: true},
+		{name: "missing -> err", set: false, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.set {
+				t.Setenv("TRY_SLICE_POS", tt.value)
+			}
+			// The third position accepts anything.
+			got, err := goenv.TryGetEnvStringSlicePositional("TRY_SLICE_POS", noSpaces, isPort, nil)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("TryGetEnvStringSlicePositional() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && !slices.Equal(got, tt.want) {
+				t.Errorf("TryGetEnvStringSlicePositional() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	t.Run("no elements and no validators -> err", func(t *testing.T) {
+		t.Setenv("TRY_SLICE_POS", ",,")
+		if _, err := goenv.TryGetEnvStringSlicePositional("TRY_SLICE_POS"); !errors.Is(err, goenv.ErrNotFound) {
+			t.Errorf("TryGetEnvStringSlicePositional() error = %v, want ErrNotFound", err)
+		}
+	})
+}
+
+/* ---------- string slice from several keys ---------- */
+
+func TestGetEnvStringSliceFromKeys(t *testing.T) {
+	keys := []string{"ENV_SLICE_SRC_A", "ENV_SLICE_SRC_B", "ENV_SLICE_SRC_C"}
+
+	t.Run("overlapping values", func(t *testing.T) {
+		t.Setenv("ENV_SLICE_SRC_A", "a,b,c")
+		t.Setenv("ENV_SLICE_SRC_B", "c, d ,a,e")
+
+		got := goenv.GetEnvStringSliceFromKeys(keys, []string{"x"})
+		if want := []string{"a", "b", "c", "d", "e"}; !slices.Equal(got, want) {
+			t.Errorf("GetEnvStringSliceFromKeys() = %q, want %q", got, want)
+		}
+	})
+
+	t.Run("order follows keys", func(t *testing.T) {
+		t.Setenv("ENV_SLICE_SRC_B", "b")
+		t.Setenv("ENV_SLICE_SRC_C", "c,b")
+
+		got := goenv.GetEnvStringSliceFromKeys([]string{"ENV_SLICE_SRC_C", "ENV_SLICE_SRC_B"}, nil)
+		if want := []string{"c", "b"}; !slices.Equal(got, want) {
+			t.Errorf("GetEnvStringSliceFromKeys() = %q, want %q", got, want)
+		}
+	})
+
+	t.Run("none set -> fallback", func(t *testing.T) {
+		got := goenv.GetEnvStringSliceFromKeys(keys, []string{"x"})
+		if want := []string{"x"}; !slices.Equal(got, want) {
+			t.Errorf("GetEnvStringSliceFromKeys() = %q, want %q", got, want)
+		}
+	})
+}
+
+/* ---------- nil fallback handling ---------- */
+
+// TestStringSliceGettersNilFallback checks that every slice getter returns a nil
+// fallback unchanged, both when the variable is unset and when it contains no elements.
+func TestStringSliceGettersNilFallback(t *testing.T) {
+	const key = "ENV_SLICE_NIL"
+	var in goenv.Interner
+	getters := map[string]func(fallback []string) []string{
+		"GetEnvStringSlice":       func(fb []string) []string { return goenv.GetEnvStringSlice(key, fb) },
+		"GetEnvStringSliceAuto":   func(fb []string) []string { return goenv.GetEnvStringSliceAuto(key, fb) },
+		"GetEnvStringSliceStrict": func(fb []string) []string { return goenv.GetEnvStringSliceStrict(key, fb) },
+		"GetEnvStringLines":       func(fb []string) []string { return goenv.GetEnvStringLines(key, fb) },
+		"GetEnvStringSliceLazy":   func(fb []string) []string { return goenv.GetEnvStringSliceLazy(key, fb)() },
+		"GetEnvStringSlicePolicy": func(fb []string) []string { return goenv.GetEnvStringSlicePolicy(key, true, fb) },
+		"GetEnvStringSliceOpts": func(fb []string) []string {
+			return goenv.GetEnvStringSliceOpts(key, goenv.SliceOpts{TrimCutset: " ", DropEmpty: true}, fb)
+		},
+		"GetEnvStringSliceFromKeys": func(fb []string) []string { return goenv.GetEnvStringSliceFromKeys([]string{key}, fb) },
+		"GetEnvStringSliceDeprecated": func(fb []string) []string {
+			return goenv.GetEnvStringSliceDeprecated(key+"_OLD", key, nil, fb)
+		},
+		"GetEnvStringSliceNormalized": func(fb []string) []string { return goenv.GetEnvStringSliceNormalized(key, nil, fb) },
+		"Interner.GetEnvStringSlice":  func(fb []string) []string { return in.GetEnvStringSlice(key, fb) },
+	}
+
+	for name, get := range getters {
+		t.Run(name+" unset -> nil", func(t *testing.T) {
+			if got := get(nil); got != nil {
+				t.Errorf("%s() = %#v, want nil", name, got)
+			}
+		})
+		if name == "GetEnvStringSliceStrict" {
+			continue
+		}
+		t.Run(name+" no elements -> nil", func(t *testing.T) {
+			t.Setenv(key, " , ,")
+			if name == "GetEnvStringLines" {
+				t.Setenv(key, "\n# only a comment\n")
+			}
+			if got := get(nil); got != nil {
+				t.Errorf("%s() = %#v, want nil", name, got)
+			}
+		})
+	}
+}
+
+/* ---------- string slice (strict) ---------- */
+
+func TestGetEnvStringSliceStrict(t *testing.T) {
+	tests := []struct {
+		name     string
+		set      bool
+		value    string
+		fallback []string
+		want     []string
+	}{
+		{name: "single element", set: true, value: "a", fallback: []string{"x"}, want: []string{"a"}},
+		{name: "only separators -> empty", set: true, value: ",,", fallback: []string{"x"}, want: []string{}},
+		{name: "empty -> fallback", set: true, value: "", fallback: []string{"x"}, want: []string{"x"}},
+		{name: "missing -> fallback", set: false, fallback: []string{"x"}, want: []string{"x"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.set {
+				t.Setenv("ENV_SLICE_STRICT", tt.value)
+			}
+			got := goenv.GetEnvStringSliceStrict("ENV_SLICE_STRICT", tt.fallback)
+			if !slices.Equal(got, tt.want) || got == nil {
+				t.Errorf("GetEnvStringSliceStrict() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+/* ---------- only-empty values fall back ---------- */
+
+func TestStringSliceGettersOnlyEmptyFallback(t *testing.T) {
+	tests := []struct {
+		name  string
+		set   bool
+		value string
+		want  []string
+	}{
+		{name: "empty", set: true, value: "", want: []string{"fb"}},
+		{name: "separators only", set: true, value: ",,", want: []string{"fb"}},
+		{name: "whitespace elements", set: true, value: " , ", want: []string{"fb"}},
+		{name: "real single element", set: true, value: ",a,", want: []string{"a"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.set {
+				t.Setenv("ENV_SLICE_ONLY_EMPTY", tt.value)
+			}
+			if got := goenv.GetEnvStringSliceLazy("ENV_SLICE_ONLY_EMPTY", []string{"fb"})(); !slices.Equal(got, tt.want) {
+				t.Errorf("GetEnvStringSliceLazy()() = %q, want %q", got, tt.want)
+			}
+			if got := goenv.GetEnvStringSliceAuto("ENV_SLICE_ONLY_EMPTY", []string{"fb"}); !slices.Equal(got, tt.want) {
+				t.Errorf("GetEnvStringSliceAuto() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+/* ---------- int slice (all errors) ---------- */
+
+func TestTryGetEnvIntSliceAll(t *testing.T) {
+	tests := []struct {
+		name       string
+		set        bool
+		value      string
+		want       []int
+		wantErrs   []string
+		wantErrNil bool
+	}{
+		{name: "ok", set: true, value: "1, 2,3", want: []int{1, 2, 3}, wantErrNil: true},
+		{
+			name:     "multiple bad elements",
+			set:      true,
+			value:    "1,x,3,y,5",
+			want:     []int{1, 0, 3, 0, 5},
+			wantErrs: []string{`element 1 "x"`, `element 3 "y"`},
+		},
+		{name: "no elements -> err", set: true, value: ",,", wantErrs: []string{"no elements"}},
+		{name: "missing -> err", set: false, wantErrs: []string{"TRY_INT_SLICE_ALL"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.set {
+				t.Setenv("TRY_INT_SLICE_ALL", tt.value)
+			}
+			got, err := goenv.TryGetEnvIntSliceAll("TRY_INT_SLICE_ALL")
+			if (err == nil) != tt.wantErrNil {
+				t.Fatalf("TryGetEnvIntSliceAll() error = %v, want nil %v", err, tt.wantErrNil)
+			}
+			for _, want := range tt.wantErrs {
+				if !strings.Contains(err.Error(), want) {
+					t.Errorf("TryGetEnvIntSliceAll() error = %q, want it to mention %q", err, want)
+				}
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("TryGetEnvIntSliceAll() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+/* ---------- timed string slice ---------- */
+
+func TestGetEnvStringSliceTimed(t *testing.T) {
+	t.Setenv("ENV_SLICE_TIMED", strings.Repeat("item,", 1000)+"last")
+
+	got, parse := goenv.GetEnvStringSliceTimed("ENV_SLICE_TIMED", nil)
+	if len(got) != 1001 || got[0] != "item" || got[1000] != "last" {
+		t.Errorf("GetEnvStringSliceTimed() returned %d elements, want 1001 ending in last", len(got))
+	}
+	if parse < 0 {
+		t.Errorf("GetEnvStringSliceTimed() duration = %v, want >= 0", parse)
+	}
+
+	fallback := []string{"x"}
+	if got, parse := goenv.GetEnvStringSliceTimed("ENV_SLICE_TIMED_MISSING", fallback); !slices.Equal(got, fallback) || parse < 0 {
+		t.Errorf("GetEnvStringSliceTimed() = (%q, %v), want (%q, >= 0)", got, parse, fallback)
+	}
+}
+
+/* ---------- normalized string slice ---------- */
+
+func TestGetEnvStringSliceNormalized(t *testing.T) {
+	// compose folds a couple of decomposed accents into their precomposed form.
+	compose := strings.NewReplacer("e\u0301", "é", "a\u0300", "à").Replace
+
+	t.Run("per element", func(t *testing.T) {
+		calls := 0
+		counting := func(s string) string {
+			calls++
+			return compose(s)
+		}
+		t.Setenv("ENV_SLICE_NORM", "cafe\u0301, voila\u0300 ,plain")
+
+		got := goenv.GetEnvStringSliceNormalized("ENV_SLICE_NORM", counting, nil)
+		if want := []string{"café", "voilà", "plain"}; !slices.Equal(got, want) {
+			t.Errorf("GetEnvStringSliceNormalized() = %q, want %q", got, want)
+		}
+		if calls != 3 {
+			t.Errorf("normalize called %d times, want 3", calls)
+		}
+	})
+
+	t.Run("nil normalizer", func(t *testing.T) {
+		t.Setenv("ENV_SLICE_NORM", "cafe\u0301")
+		got := goenv.GetEnvStringSliceNormalized("ENV_SLICE_NORM", nil, nil)
+		if want := []string{"cafe\u0301"}; !slices.Equal(got, want) {
+			t.Errorf("GetEnvStringSliceNormalized() = %q, want %q", got, want)
+		}
+	})
+
+	t.Run("missing -> fallback untouched", func(t *testing.T) {
+		fallback := []string{"café"}
+		got := goenv.GetEnvStringSliceNormalized("ENV_SLICE_NORM", compose, fallback)
+		if !slices.Equal(got, fallback) {
+			t.Errorf("GetEnvStringSliceNormalized() = %q, want %q", got, fallback)
+		}
+	})
+}
+
+/* ---------- weighted slice ---------- */
+
+func TestTryGetEnvWeightedSlice(t *testing.T) {
+	tests := []struct {
+		name        string
+		set         bool
+		value       string
+		wantNames   []string
+		wantWeights []int
+		wantErr     bool
+	}{
+		{name: "ok", set: true, value: "a:5, b : 3,c:2", wantNames: []string{"a", "b", "c"}, wantWeights: []int{5, 3, 2}},
+		{name: "missing weight -> err", set: true, value: "a:5,b", wantErr: true},
+		{name: "empty weight -> err", set: true, value: "a:", wantErr: true},
+		{name: "zero weight -> err", set: true, value: "a:0", wantErr: true},
+		{name: "negative weight -> err", set: true, value: "a:-1", wantErr: true},
+		{name: "bad weight -> err", set: true, value: "a:x", wantErr: true},
+		{name: "missing name -> err", set: true, value: ":3", wantErr: true},
+		{name: "no entries -> err", set: true, value: ",,", wantErr: true},
+		{name: "missing -> err", set: false, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.set {
+				t.Setenv("TRY_WEIGHTED", tt.value)
+			}
+			names, weights, err := goenv.TryGetEnvWeightedSlice("TRY_WEIGHTED")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("TryGetEnvWeightedSlice() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && (!slices.Equal(names, tt.wantNames) || !slices.Equal(weights, tt.wantWeights)) {
+				t.Errorf("TryGetEnvWeightedSlice() = (%q, %v), want (%q, %v)", names, weights, tt.wantNames, tt.wantWeights)
+			}
+		})
+	}
+}
+
+/* ---------- string slice source report ---------- */
+
+func TestGetEnvStringSliceReport(t *testing.T) {
+	tests := []struct {
+		name        string
+		set         bool
+		value       string
+		want        []string
+		wantFromEnv bool
+	}{
+		{name: "from env", set: true, value: "a,b", want: []string{"a", "b"}, wantFromEnv: true},
+		{name: "no elements -> fallback", set: true, value: ",", want: []string{"x"}},
+		{name: "missing -> fallback", set: false, want: []string{"x"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.set {
+				t.Setenv("ENV_SLICE_REPORT", tt.value)
+			}
+			got, fromEnv := goenv.GetEnvStringSliceReport("ENV_SLICE_REPORT", []string{"x"})
+			if !slices.Equal(got, tt.want) || fromEnv != tt.wantFromEnv {
+				t.Errorf("GetEnvStringSliceReport() = (%q, %v), want (%q, %v)", got, fromEnv, tt.want, tt.wantFromEnv)
+			}
+		})
+	}
+}
+
+/* ---------- trailing separator ---------- */
+
+func TestSetSliceTrailingSepDrop(t *testing.T) {
+	t.Cleanup(func() { goenv.SetSliceTrailingSepDrop(true) })
+
+	tests := []struct {
+		name       string
+		drop       bool
+		value      string
+		wantPolicy []string
+		wantOpts   []string
+	}{
+		{name: "drop (default)", drop: true, value: "a,b,", wantPolicy: []string{"a", "b"}, wantOpts: []string{"a", "b"}},
+		{name: "drop only one", drop: true, value: "a,b,,", wantPolicy: []string{"a", "b", ""}, wantOpts: []string{"a", "b", ""}},
+		{name: "drop ignores trailing space", drop: true, value: "a,b, ", wantPolicy: []string{"a", "b"}, wantOpts: []string{"a", "b", " "}},
+		{name: "keep", drop: false, value: "a,b,", wantPolicy: []string{"a", "b", ""}, wantOpts: []string{"a", "b", ""}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			goenv.SetSliceTrailingSepDrop(tt.drop)
+			t.Setenv("ENV_SLICE_TRAILING", tt.value)
+
+			if got := goenv.GetEnvStringSlicePolicy("ENV_SLICE_TRAILING", true, nil); !slices.Equal(got, tt.wantPolicy) {
+				t.Errorf("GetEnvStringSlicePolicy() = %q, want %q", got, tt.wantPolicy)
+			}
+			if got := goenv.GetEnvStringSliceOpts("ENV_SLICE_TRAILING", goenv.SliceOpts{}, nil); !slices.Equal(got, tt.wantOpts) {
+				t.Errorf("GetEnvStringSliceOpts() = %q, want %q", got, tt.wantOpts)
+			}
+			// Getters that drop empty elements are unaffected.
+			if got, want := goenv.GetEnvStringSliceStrict("ENV_SLICE_TRAILING", nil), []string{"a", "b"}; !slices.Equal(got, want) {
+				t.Errorf("GetEnvStringSliceStrict() = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+/* ---------- string slice (parse deadline) ---------- */
+
+func TestTryGetEnvStringSliceDeadline(t *testing.T) {
+	t.Run("completes", func(t *testing.T) {
+		t.Setenv("TRY_SLICE_DEADLINE", "a; b ;;c")
+		got, err := goenv.TryGetEnvStringSliceDeadline("TRY_SLICE_DEADLINE", ";", time.Second)
+		if err != nil {
+			t.Fatalf("TryGetEnvStringSliceDeadline() failed: %v", err)
+		}
+		if want := []string{"a", "b", "c"}; !slices.Equal(got, want) {
+			t.Errorf("TryGetEnvStringSliceDeadline() = %q, want %q", got, want)
+		}
+	})
+
+	t.Run("no deadline", func(t *testing.T) {
+		t.Setenv("TRY_SLICE_DEADLINE", "a,b")
+		if got, err := goenv.TryGetEnvStringSliceDeadline("TRY_SLICE_DEADLINE", ",", 0); err != nil || len(got) != 2 {
+			t.Errorf("TryGetEnvStringSliceDeadline() = (%q, %v), want 2 elements", got, err)
+		}
+	})
+
+	t.Run("huge value times out", func(t *testing.T) {
+		t.Setenv("TRY_SLICE_DEADLINE", strings.Repeat("element,", 200000))
+		_, err := goenv.TryGetEnvStringSliceDeadline("TRY_SLICE_DEADLINE", ",", time.Nanosecond)
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Fatalf("TryGetEnvStringSliceDeadline() error = %v, want context.DeadlineExceeded", err)
+		}
+		var envErr *goenv.EnvError
+		if !errors.As(err, &envErr) || envErr.Key != "TRY_SLICE_DEADLINE" || !errors.Is(err, goenv.ErrParse) {
+			t.Errorf("TryGetEnvStringSliceDeadline() error = %v, want an ErrParse *EnvError for TRY_SLICE_DEADLINE", err)
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		t.Setenv("TRY_SLICE_DEADLINE", "a,b")
+		if _, err := goenv.TryGetEnvStringSliceDeadline("TRY_SLICE_DEADLINE", "", time.Second); !errors.Is(err, goenv.ErrParse) {
+			t.Errorf("TryGetEnvStringSliceDeadline() error = %v with empty separator, want ErrParse", err)
+		}
+		if _, err := goenv.TryGetEnvStringSliceDeadline("TRY_SLICE_DEADLINE_MISSING", ",", time.Second); err == nil {
+			t.Error("TryGetEnvStringSliceDeadline() should fail with missing variable")
+		}
+	})
+}
+
+/* ---------- string slice pipeline ---------- */
+
+func TestGetEnvStringSlicePipeline(t *testing.T) {
+	lower := func(in []string) []string {
+		out := make([]string, len(in))
+		for i, s := range in {
+			out[i] = strings.ToLower(s)
+		}
+		return out
+	}
+	dedup := func(in []string) []string {
+		seen := make(map[string]bool)
+		var out []string
+		for _, s := range in {
+			if !seen[s] {
+				seen[s] = true
+				out = append(out, s)
+			}
+		}
+		return out
+	}
+
+	t.Setenv("ENV_SLICE_PIPE", "B,a,b,A")
+
+	if got, want := goenv.GetEnvStringSlicePipeline("ENV_SLICE_PIPE", nil, lower, dedup), []string{"b", "a"}; !slices.Equal(got, want) {
+		t.Errorf("lower then dedup = %q, want %q", got, want)
+	}
+	// Deduping first keeps case-distinct values, which lowering then collides.
+	if got, want := goenv.GetEnvStringSlicePipeline("ENV_SLICE_PIPE", nil, dedup, lower), []string{"b", "a", "b", "a"}; !slices.Equal(got, want) {
+		t.Errorf("dedup then lower = %q, want %q", got, want)
+	}
+	if got, want := goenv.GetEnvStringSlicePipeline("ENV_SLICE_PIPE", nil, nil), []string{"B", "a", "b", "A"}; !slices.Equal(got, want) {
+		t.Errorf("nil step = %q, want %q", got, want)
+	}
+	if got, want := goenv.GetEnvStringSlicePipeline("ENV_SLICE_PIPE_MISSING", []string{"X"}, lower), []string{"X"}; !slices.Equal(got, want) {
+		t.Errorf("missing -> fallback = %q, want %q", got, want)
+	}
+}
+
+/* ---------- string slice (min distinct) ---------- */
+
+func TestTryGetEnvStringSliceMinDistinct(t *testing.T) {
+	tests := []struct {
+		name    string
+		set     bool
+		value   string
+		min     int
+		want    []string
+		wantErr bool
+	}{
+		{name: "enough distinct", set: true, value: "n1,n2,n3", min: 3, want: []string{"n1", "n2", "n3"}},
+		{name: "dedupes in order", set: true, value: "n2,n1,n2,n3", min: 3, want: []string{"n2", "n1", "n3"}},
+		{name: "duplicates fall short -> err", set: true, value: "n1,n1,n2,n2,n1", min: 3, wantErr: true},
+		{name: "no elements -> err", set: true, value: ",,", min: 0, wantErr: true},
+		{name: "missing -> err", set: false, min: 1, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.set {
+				t.Setenv("TRY_SLICE_DISTINCT", tt.value)
+			}
+			got, err := goenv.TryGetEnvStringSliceMinDistinct("TRY_SLICE_DISTINCT", tt.min)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("TryGetEnvStringSliceMinDistinct() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && !slices.Equal(got, tt.want) {
+				t.Errorf("TryGetEnvStringSliceMinDistinct() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+/* ---------- string slice (whole-list validation) ---------- */
+
+func TestTryGetEnvStringSliceValidateAll(t *testing.T) {
+	errBadWeights := errors.New("weights must be three integers summing to 100")
+	sumTo100 := func(elems []string) error {
+		if len(elems) != 3 {
+			return errBadWeights
+		}
+		sum := 0
+		for _, e := range elems {
+			n, err := strconv.Atoi(e)
+			if err != nil {
+				return errBadWeights
+			}
+			sum += n
+		}
+		if sum != 100 {
+			return errBadWeights
+		}
+		return nil
+	}
+
+	tests := []struct {
+		name    string
+		set     bool
+		value   string
+		want    []string
+		wantErr bool
+	}{
+		{name: "valid", set: true, value: "50,30,20", want: []string{"50", "30", "20"}},
+		{name: "wrong sum -> err", set: true, value: "50,30,30", wantErr: true},
+		{name: "wrong count -> err", set: true, value: "50,50", wantErr: true},
+		{name: "missing -> err", set: false, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.set {
+				t.Setenv("TRY_SLICE_VALIDATE_ALL", tt.value)
+			}
+			got, err := goenv.TryGetEnvStringSliceValidateAll("TRY_SLICE_VALIDATE_ALL", sumTo100)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("TryGetEnvStringSliceValidateAll() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.set && tt.wantErr && !errors.Is(err, errBadWeights) {
+				t.Errorf("TryGetEnvStringSliceValidateAll() error = %v, want it to wrap the validator error", err)
+			}
+			if err == nil && !slices.Equal(got, tt.want) {
+				t.Errorf("TryGetEnvStringSliceValidateAll() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	t.Run("no elements -> err before validate", func(t *testing.T) {
+		t.Setenv("TRY_SLICE_VALIDATE_ALL", ",,")
+		called := false
+		_, err := goenv.TryGetEnvStringSliceValidateAll("TRY_SLICE_VALIDATE_ALL", func([]string) error {
+			called = true
+			return nil
+		})
+		if !errors.Is(err, goenv.ErrNotFound) {
+			t.Errorf("TryGetEnvStringSliceValidateAll() error = %v, want ErrNotFound", err)
+		}
+		if called {
+			t.Error("TryGetEnvStringSliceValidateAll() called validate with no elements")
+		}
+	})
+}
+
+/* ---------- string slice (conditionally required) ---------- */
+
+func TestTryGetEnvStringSliceRequiredIf(t *testing.T) {
+	tests := []struct {
+		name    string
+		env     map[string]string
+		want    []string
+		wantErr bool
+	}{
+		{name: "condition true and missing -> err", env: map[string]string{"TLS_ENABLED": "true"}, wantErr: true},
+		{name: "condition true and no elements -> err", env: map[string]string{"TLS_ENABLED": "true", "TLS_HOSTS": ","}, wantErr: true},
+		{name: "condition true and present", env: map[string]string{"TLS_ENABLED": "true", "TLS_HOSTS": "a.com,b.com"}, want: []string{"a.com", "b.com"}},
+		{name: "condition false and missing", env: map[string]string{"TLS_ENABLED": "false"}, want: []string{}},
+		{name: "condition unset and missing", env: map[string]string{}, want: []string{}},
+		{name: "condition empty and missing", env: map[string]string{"TLS_ENABLED": ""}, want: []string{}},
+		{name: "condition false and present", env: map[string]string{"TLS_ENABLED": "false", "TLS_HOSTS": "a.com"}, want: []string{"a.com"}},
+		{name: "invalid condition -> err", env: map[string]string{"TLS_ENABLED": "maybe"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for k, v := range tt.env {
+				t.Setenv(k, v)
+			}
+			got, err := goenv.TryGetEnvStringSliceRequiredIf("TLS_HOSTS", "TLS_ENABLED")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("TryGetEnvStringSliceRequiredIf() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && (!slices.Equal(got, tt.want) || got == nil) {
+				t.Errorf("TryGetEnvStringSliceRequiredIf() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+/* ---------- generic slice ---------- */
+
+func TestGetEnvSliceOr(t *testing.T) {
+	tests := []struct {
+		name     string
+		set      bool
+		value    string
+		sep      string
+		fallback []int
+		want     []int
+	}{
+		{name: "ok", set: true, value: "1, 2,3", sep: ",", want: []int{1, 2, 3}},
+		{name: "custom sep", set: true, value: "1|2", sep: "|", want: []int{1, 2}},
+		{name: "bad element -> fallback", set: true, value: "1,x,3", sep: ",", fallback: []int{9}, want: []int{9}},
+		{name: "empty sep -> fallback", set: true, value: "1,2", sep: "", fallback: []int{9}, want: []int{9}},
+		{name: "no elements -> fallback", set: true, value: ",,", sep: ",", fallback: []int{9}, want: []int{9}},
+		{name: "missing -> fallback", set: false, sep: ",", fallback: []int{9}, want: []int{9}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.set {
+				t.Setenv("ENV_SLICE_GENERIC", tt.value)
+			}
+			if got := goenv.GetEnvSliceOr("ENV_SLICE_GENERIC", tt.sep, strconv.Atoi, tt.fallback); !slices.Equal(got, tt.want) {
+				t.Errorf("GetEnvSliceOr() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetEnvSliceOrMatchesDedicatedHelpers(t *testing.T) {
+	for _, value := range []string{"1,2,3", " 7 , ,8 ", "-1", "1,x", ",", ""} {
+		t.Run(value, func(t *testing.T) {
+			t.Setenv("ENV_SLICE_GENERIC", value)
+			fallback := []int{42}
+			want := goenv.GetEnvIntSlice("ENV_SLICE_GENERIC", fallback)
+			if got := goenv.GetEnvSliceOr("ENV_SLICE_GENERIC", ",", strconv.Atoi, fallback); !slices.Equal(got, want) {
+				t.Errorf("GetEnvSliceOr() = %v, GetEnvIntSlice() = %v", got, want)
+			}
+			if _, err := goenv.TryGetEnvIntSlice("ENV_SLICE_GENERIC"); err != nil {
+				return
+			}
+			if all, err := goenv.TryGetEnvIntSliceAll("ENV_SLICE_GENERIC"); err != nil || !slices.Equal(all, want) {
+				t.Errorf("TryGetEnvIntSliceAll() = %v, %v, GetEnvIntSlice() = %v", all, err, want)
+			}
+		})
+	}
+
+	t.Setenv("ENV_SLICE_GENERIC", "1s,2m,3h")
+	sum, err := goenv.TryGetEnvDurationSliceSum("ENV_SLICE_GENERIC")
+	if err != nil {
+		t.Fatalf("TryGetEnvDurationSliceSum() failed: %v", err)
+	}
+	var total time.Duration
+	for _, d := range goenv.GetEnvSliceOr("ENV_SLICE_GENERIC", ",", time.ParseDuration, nil) {
+		total += d
+	}
+	if total != sum {
+		t.Errorf("sum of GetEnvSliceOr() = %v, TryGetEnvDurationSliceSum() = %v", total, sum)
+	}
+}
+
+/* ---------- each hook ---------- */
+
+func TestGetEnvStringSliceEachHook(t *testing.T) {
+	tests := []struct {
+		name     string
+		set      bool
+		value    string
+		fallback []string
+		want     []string
+	}{
+		{name: "env", set: true, value: "a, b,,c", want: []string{"a", "b", "c"}},
+		{name: "missing -> fallback", set: false, fallback: []string{"x", "y"}, want: []string{"x", "y"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.set {
+				t.Setenv("ENV_SLICE_HOOK", tt.value)
+			}
+			var indexes []int
+			var seen []string
+			got := goenv.GetEnvStringSliceEachHook("ENV_SLICE_HOOK", tt.fallback, func(i int, e string) {
+				indexes = append(indexes, i)
+				seen = append(seen, e)
+			})
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("GetEnvStringSliceEachHook() = %v, want %v", got, tt.want)
+			}
+			if !slices.Equal(seen, tt.want) {
+				t.Errorf("hook saw %v, want %v", seen, tt.want)
+			}
+			for i, idx := range indexes {
+				if idx != i {
+					t.Errorf("hook call %d got index %d", i, idx)
+				}
+			}
+		})
+	}
+
+	t.Run("nil hook", func(t *testing.T) {
+		t.Setenv("ENV_SLICE_HOOK", "a,b")
+		if got := goenv.GetEnvStringSliceEachHook("ENV_SLICE_HOOK", nil, nil); !slices.Equal(got, []string{"a", "b"}) {
+			t.Errorf("GetEnvStringSliceEachHook() = %v, want [a b]", got)
+		}
+	})
+}
+
+/* ---------- sorted func ---------- */
+
+func TestGetEnvStringSliceSortedFunc(t *testing.T) {
+	reverse := func(a, b string) bool { return a > b }
+	byLen := func(a, b string) bool { return len(a) < len(b) }
+
+	tests := []struct {
+		name     string
+		set      bool
+		value    string
+		less     func(a, b string) bool
+		fallback []string
+		want     []string
+	}{
+		{name: "nil less byte-wise", set: true, value: "b,C,a", want: []string{"C", "a", "b"}},
+		{name: "reverse", set: true, value: "b,c,a", less: reverse, want: []string{"c", "b", "a"}},
+		{name: "stable", set: true, value: "bb,a,cc,d,aa", less: byLen, want: []string{"a", "d", "bb", "cc", "aa"}},
+		{name: "missing -> sorted fallback", set: false, less: reverse, fallback: []string{"x", "z", "y"}, want: []string{"z", "y", "x"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.set {
+				t.Setenv("ENV_SLICE_SORTED", tt.value)
+			}
+			orig := slices.Clone(tt.fallback)
+			if got := goenv.GetEnvStringSliceSortedFunc("ENV_SLICE_SORTED", tt.less, tt.fallback); !slices.Equal(got, tt.want) {
+				t.Errorf("GetEnvStringSliceSortedFunc() = %v, want %v", got, tt.want)
+			}
+			if !slices.Equal(tt.fallback, orig) {
+				t.Errorf("fallback modified to %v", tt.fallback)
+			}
+		})
+	}
+}
+
+/* ---------- dedup report ---------- */
+
+func TestGetEnvStringSliceDedupReport(t *testing.T) {
+	tests := []struct {
+		name       string
+		set        bool
+		value      string
+		fallback   []string
+		wantUnique []string
+		wantDups   []string
+	}{
+		{name: "repeats", set: true, value: "a,b,a,c,b,a", wantUnique: []string{"a", "b", "c"}, wantDups: []string{"a", "b", "a"}},
+		{name: "no repeats", set: true, value: "a, b", wantUnique: []string{"a", "b"}},
+		{name: "missing -> fallback", set: false, fallback: []string{"x", "x"}, wantUnique: []string{"x", "x"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.set {
+				t.Setenv("ENV_SLICE_DEDUP", tt.value)
+			}
+			unique, dups := goenv.GetEnvStringSliceDedupReport("ENV_SLICE_DEDUP", tt.fallback)
+			if !slices.Equal(unique, tt.wantUnique) {
+				t.Errorf("GetEnvStringSliceDedupReport() unique = %v, want %v", unique, tt.wantUnique)
+			}
+			if !slices.Equal(dups, tt.wantDups) {
+				t.Errorf("GetEnvStringSliceDedupReport() duplicates = %v, want %v", dups, tt.wantDups)
+			}
+		})
+	}
+}
+
+/* ---------- parallel ---------- */
+
+func TestTryGetEnvSliceParallel(t *testing.T) {
+	var parts []string
+	var want []int
+	for i := range 200 {
+		parts = append(parts, strconv.Itoa(i*3))
+		want = append(want, i*3)
+	}
+	t.Setenv("ENV_SLICE_PARALLEL", strings.Join(parts, ","))
+
+	for _, workers := range []int{-1, 0, 1, 3, 8, 500} {
+		t.Run(strconv.Itoa(workers), func(t *testing.T) {
+			got, err := goenv.TryGetEnvSliceParallel("ENV_SLICE_PARALLEL", ",", strconv.Atoi, workers)
+			if err != nil {
+				t.Fatalf("TryGetEnvSliceParallel() failed: %v", err)
+			}
+			if !slices.Equal(got, want) {
+				t.Errorf("TryGetEnvSliceParallel() = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestTryGetEnvSliceParallelErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		set     bool
+		value   string
+		sep     string
+		wantErr string
+	}{
+		{name: "first bad element", set: true, value: "1,x,3,y", sep: ",", wantErr: `element 1 "x"`},
+		{name: "empty sep", set: true, value: "1", sep: "", wantErr: "separator"},
+		{name: "no elements", set: true, value: " , ", sep: ",", wantErr: "contains no elements"},
+		{name: "missing", set: false, sep: ",", wantErr: "unable to find"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.set {
+				t.Setenv("ENV_SLICE_PARALLEL", tt.value)
+			}
+			for range 20 {
+				_, err := goenv.TryGetEnvSliceParallel("ENV_SLICE_PARALLEL", tt.sep, strconv.Atoi, 4)
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("TryGetEnvSliceParallel() error = %v, want it to contain %q", err, tt.wantErr)
+				}
+			}
+		})
+	}
+}
+
+/* ---------- string slice ---------- */
+
+func TestGetEnvStringSlice(t *testing.T) {
+	tests := []struct {
+		name     string
+		set      bool
+		value    string
+		fallback []string
+		want     []string
+	}{
+		{name: "single element", set: true, value: "a.com", want: []string{"a.com"}},
+		{name: "several", set: true, value: "a.com,b.com,c.com", want: []string{"a.com", "b.com", "c.com"}},
+		{name: "trailing comma", set: true, value: "a,b,", want: []string{"a", "b"}},
+		{name: "internal whitespace", set: true, value: " a , b ", want: []string{"a", "b"}},
+		{name: "empty -> fallback", set: true, value: "", fallback: []string{"fb"}, want: []string{"fb"}},
+		{name: "missing -> fallback", set: false, fallback: []string{"fb"}, want: []string{"fb"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.set {
+				t.Setenv("ENV_STRING_SLICE", tt.value)
+			}
+			if got := goenv.GetEnvStringSlice("ENV_STRING_SLICE", tt.fallback); !slices.Equal(got, tt.want) {
+				t.Errorf("GetEnvStringSlice() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTryGetEnvStringSlice(t *testing.T) {
+	tests := []struct {
+		name    string
+		set     bool
+		value   string
+		want    []string
+		wantErr bool
+	}{
+		{name: "single element", set: true, value: "a", want: []string{"a"}},
+		{name: "trailing comma", set: true, value: "a,", want: []string{"a"}},
+		{name: "internal whitespace", set: true, value: " a , b ", want: []string{"a", "b"}},
+		{name: "no elements -> error", set: true, value: ", ,", wantErr: true},
+		{name: "empty -> error", set: true, value: "", wantErr: true},
+		{name: "missing -> error", set: false, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.set {
+				t.Setenv("ENV_STRING_SLICE", tt.value)
+			}
+			got, err := goenv.TryGetEnvStringSlice("ENV_STRING_SLICE")
+			if err != nil {
+				if !tt.wantErr {
+					t.Errorf("TryGetEnvStringSlice() failed: %v", err)
+				}
+				return
+			}
+			if tt.wantErr {
+				t.Fatal("TryGetEnvStringSlice() succeeded unexpectedly")
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("TryGetEnvStringSlice() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMustGetEnvStringSlice(t *testing.T) {
+	tests := []struct {
+		name      string
+		set       bool
+		value     string
+		want      []string
+		wantPanic bool
+	}{
+		{name: "ok", set: true, value: " a , b ,", want: []string{"a", "b"}},
+		{name: "empty -> panic", set: true, value: "", wantPanic: true},
+		{name: "missing -> panic", set: false, wantPanic: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.set {
+				t.Setenv("ENV_STRING_SLICE", tt.value)
+			}
+			defer expectPanic(t, tt.wantPanic)()
+			if got := goenv.MustGetEnvStringSlice("ENV_STRING_SLICE"); !slices.Equal(got, tt.want) {
+				t.Errorf("MustGetEnvStringSlice() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+/* ---------- no trim ---------- */
+
+func TestGetEnvStringSliceNoTrim(t *testing.T) {
+	tests := []struct {
+		name     string
+		set      bool
+		value    string
+		fallback []string
+		want     []string
+	}{
+		{name: "spaces preserved", set: true, value: " a, b ,c ", want: []string{" a", " b ", "c "}},
+		{name: "empties kept", set: true, value: "a,,b", want: []string{"a", "", "b"}},
+		{name: "whitespace-only elements", set: true, value: " , ", want: []string{" ", " "}},
+		{name: "trailing separator dropped", set: true, value: "a ,", want: []string{"a "}},
+		{name: "only separators -> fallback", set: true, value: ",,", fallback: []string{"fb"}, want: []string{"fb"}},
+		{name: "missing -> fallback", set: false, fallback: []string{"fb"}, want: []string{"fb"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.set {
+				t.Setenv("ENV_SLICE_NO_TRIM", tt.value)
+			}
+			if got := goenv.GetEnvStringSliceNoTrim("ENV_SLICE_NO_TRIM", tt.fallback); !slices.Equal(got, tt.want) {
+				t.Errorf("GetEnvStringSliceNoTrim() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+/* ---------- string slice sep ---------- */
+
+func TestGetEnvStringSliceSep(t *testing.T) {
+	tests := []struct {
+		name     string
+		set      bool
+		value    string
+		sep      string
+		fallback []string
+		want     []string
+	}{
+		{name: "colon", set: true, value: "/usr/bin:/bin", sep: ":", want: []string{"/usr/bin", "/bin"}},
+		{name: "semicolon", set: true, value: "a; b ;c", sep: ";", want: []string{"a", "b", "c"}},
+		{name: "consecutive separators", set: true, value: "a::b:", sep: ":", want: []string{"a", "b"}},
+		{name: "multi-char sep", set: true, value: "a||b", sep: "||", want: []string{"a", "b"}},
+		{name: "empty sep -> fallback", set: true, value: "ab", sep: "", fallback: []string{"fb"}, want: []string{"fb"}},
+		{name: "missing -> fallback", set: false, sep: ":", fallback: []string{"fb"}, want: []string{"fb"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.set {
+				t.Setenv("ENV_SLICE_SEP", tt.value)
+			}
+			if got := goenv.GetEnvStringSliceSep("ENV_SLICE_SEP", tt.sep, tt.fallback); !slices.Equal(got, tt.want) {
+				t.Errorf("GetEnvStringSliceSep() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTryGetEnvStringSliceSep(t *testing.T) {
+	tests := []struct {
+		name    string
+		set     bool
+		value   string
+		sep     string
+		want    []string
+		wantErr bool
+	}{
+		{name: "colon", set: true, value: "a:b", sep: ":", want: []string{"a", "b"}},
+		{name: "consecutive separators", set: true, value: ";;a;;", sep: ";", want: []string{"a"}},
+		{name: "empty sep -> error", set: true, value: "ab", sep: "", wantErr: true},
+		{name: "no elements -> error", set: true, value: ": :", sep: ":", wantErr: true},
+		{name: "missing -> error", set: false, sep: ":", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.set {
+				t.Setenv("ENV_SLICE_SEP", tt.value)
+			}
+			got, err := goenv.TryGetEnvStringSliceSep("ENV_SLICE_SEP", tt.sep)
+			if err != nil {
+				if !tt.wantErr {
+					t.Errorf("TryGetEnvStringSliceSep() failed: %v", err)
+				}
+				return
+			}
+			if tt.wantErr {
+				t.Fatal("TryGetEnvStringSliceSep() succeeded unexpectedly")
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("TryGetEnvStringSliceSep() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTryGetEnvStringSliceSepEmptySeparator(t *testing.T) {
+	t.Setenv("ENV_SLICE_SEP", "ab")
+	_, err := goenv.TryGetEnvStringSliceSep("ENV_SLICE_SEP", "")
+	var envErr *goenv.EnvError
+	if !errors.As(err, &envErr) || envErr.Key != "ENV_SLICE_SEP" || !errors.Is(err, goenv.ErrParse) {
+		t.Errorf("TryGetEnvStringSliceSep() error = %v, want an ErrParse *EnvError for ENV_SLICE_SEP", err)
+	}
+}
+
+func TestMustGetEnvStringSliceSep(t *testing.T) {
+	tests := []struct {
+		name      string
+		value     string
+		sep       string
+		want      []string
+		wantPanic bool
+	}{
+		{name: "ok", value: "a:b", sep: ":", want: []string{"a", "b"}},
+		{name: "empty sep -> panic", value: "ab", sep: "", wantPanic: true},
+		{name: "no elements -> panic", value: "::", sep: ":", wantPanic: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("ENV_SLICE_SEP", tt.value)
+			defer expectPanic(t, tt.wantPanic)()
+			if got := goenv.MustGetEnvStringSliceSep("ENV_SLICE_SEP", tt.sep); !slices.Equal(got, tt.want) {
+				t.Errorf("MustGetEnvStringSliceSep() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+/* ---------- ordered ---------- */
+
+func TestTryGetEnvStringSliceOrdered(t *testing.T) {
+	canonical := []string{"fetch", "build", "test", "deploy"}
+	tests := []struct {
+		name    string
+		set     bool
+		value   string
+		want    []string
+		wantErr string
+	}{
+		{name: "in order", set: true, value: "fetch,build,test,deploy", want: []string{"fetch", "build", "test", "deploy"}},
+		{name: "subset in order", set: true, value: "build, deploy", want: []string{"build", "deploy"}},
+		{name: "repeat allowed", set: true, value: "test,test,deploy", want: []string{"test", "test", "deploy"}},
+		{name: "out of order", set: true, value: "build,deploy,test", wantErr: `element 2 "test" must come before "deploy"`},
+		{name: "unknown element", set: true, value: "build,lint", wantErr: `element 1 "lint" is not in the canonical order`},
+		{name: "missing", set: false, wantErr: "unable to find env variable"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.set {
+				t.Setenv("ENV_SLICE_ORDERED", tt.value)
+			}
+			got, err := goenv.TryGetEnvStringSliceOrdered("ENV_SLICE_ORDERED", canonical)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("TryGetEnvStringSliceOrdered() error = %v, want it to contain %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("TryGetEnvStringSliceOrdered() failed: %v", err)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("TryGetEnvStringSliceOrdered() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+/* ---------- int slice ---------- */
+
+func TestGetEnvIntSlice(t *testing.T) {
+	tests := []struct {
+		name     string
+		set      bool
+		value    string
+		fallback []int
+		want     []int
+	}{
+		{name: "valid", set: true, value: "1,2,3,5,8", want: []int{1, 2, 3, 5, 8}},
+		{name: "trimmed", set: true, value: " 1 , -2 ,", want: []int{1, -2}},
+		{name: "bad element -> fallback", set: true, value: "1,2,x", fallback: []int{9}, want: []int{9}},
+		{name: "empty -> fallback", set: true, value: "", fallback: []int{9}, want: []int{9}},
+		{name: "missing -> fallback", set: false, fallback: []int{9}, want: []int{9}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.set {
+				t.Setenv("ENV_INT_SLICE", tt.value)
+			}
+			if got := goenv.GetEnvIntSlice("ENV_INT_SLICE", tt.fallback); !slices.Equal(got, tt.want) {
+				t.Errorf("GetEnvIntSlice() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTryGetEnvIntSlice(t *testing.T) {
+	tests := []struct {
+		name    string
+		set     bool
+		value   string
+		want    []int
+		wantErr string
+	}{
+		{name: "valid", set: true, value: "1,2,3", want: []int{1, 2, 3}},
+		{name: "bad element", set: true, value: "1,2,x", wantErr: `element 2 "x" is not an integer`},
+		{name: "no elements", set: true, value: " , ", wantErr: "contains no elements"},
+		{name: "missing", set: false, wantErr: "unable to find env variable"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.set {
+				t.Setenv("ENV_INT_SLICE", tt.value)
+			}
+			got, err := goenv.TryGetEnvIntSlice("ENV_INT_SLICE")
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("TryGetEnvIntSlice() error = %v, want it to contain %q", err, tt.wantErr)
+				}
+				if got != nil {
+					t.Errorf("TryGetEnvIntSlice() = %v on error, want nil", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("TryGetEnvIntSlice() failed: %v", err)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("TryGetEnvIntSlice() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMustGetEnvIntSlice(t *testing.T) {
+	tests := []struct {
+		name      string
+		value     string
+		want      []int
+		wantPanic bool
+	}{
+		{name: "valid", value: "4,5", want: []int{4, 5}},
+		{name: "bad element -> panic", value: "4,five", wantPanic: true},
+		{name: "empty -> panic", value: "", wantPanic: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("ENV_INT_SLICE", tt.value)
+			defer expectPanic(t, tt.wantPanic)()
+			if got := goenv.MustGetEnvIntSlice("ENV_INT_SLICE"); !slices.Equal(got, tt.want) {
+				t.Errorf("MustGetEnvIntSlice() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+/* ---------- typed values ---------- */
+
+func TestTryGetEnvTypedValues(t *testing.T) {
+	tests := []struct {
+		name    string
+		set     bool
+		value   string
+		want    []any
+		wantErr string
+	}{
+		{name: "each type", set: true, value: "5:int,2.5:float,true:bool,hello:string", want: []any{5, 2.5, true, "hello"}},
+		{name: "string with colon", set: true, value: "http://x:8080:string", want: []any{"http://x:8080"}},
+		{name: "spaces around tag", set: true, value: " -3 : int ", want: []any{-3}},
+		{name: "mismatch", set: true, value: "1:int,abc:float", wantErr: `element 1 "abc:float" is not a valid float`},
+		{name: "bad bool", set: true, value: "yes:bool", wantErr: `element 0 "yes:bool" is not a valid bool`},
+		{name: "unknown tag", set: true, value: "1:uint", wantErr: `element 0 "1:uint" has unknown type "uint"`},
+		{name: "no tag", set: true, value: "5", wantErr: `element 0 "5" has no type annotation`},
+		{name: "missing", set: false, wantErr: "unable to find env variable"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.set {
+				t.Setenv("ENV_TYPED_VALUES", tt.value)
+			}
+			got, err := goenv.TryGetEnvTypedValues("ENV_TYPED_VALUES")
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("TryGetEnvTypedValues() error = %v, want it to contain %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("TryGetEnvTypedValues() failed: %v", err)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("TryGetEnvTypedValues() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+/* ---------- validated fallback ---------- */
+
+func TestTryGetEnvStringSliceValidatedFallback(t *testing.T) {
+	lower := func(s string) error {
+		if s != strings.ToLower(s) {
+			return errors.New("must be lower case")
+		}
+		return nil
+	}
+
+	tests := []struct {
+		name     string
+		set      bool
+		value    string
+		validate func(string) error
+		fallback []string
+		want     []string
+		wantErr  string
+	}{
+		{name: "env valid", set: true, value: "a,b", validate: lower, fallback: []string{"X"}, want: []string{"a", "b"}},
+		{name: "env invalid", set: true, value: "a,B", validate: lower, wantErr: `element 1 "B": must be lower case`},
+		{name: "fallback valid", set: false, validate: lower, fallback: []string{"x"}, want: []string{"x"}},
+		{name: "fallback invalid", set: false, validate: lower, fallback: []string{"x", "Y"}, wantErr: `fallback element 1 "Y": must be lower case`},
+		{name: "nil validate", set: false, fallback: []string{"Y"}, want: []string{"Y"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.set {
+				t.Setenv("ENV_SLICE_VALID_FB", tt.value)
+			}
+			got, err := goenv.TryGetEnvStringSliceValidatedFallback("ENV_SLICE_VALID_FB", tt.validate, tt.fallback)
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Fatalf("TryGetEnvStringSliceValidatedFallback() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("TryGetEnvStringSliceValidatedFallback() failed: %v", err)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("TryGetEnvStringSliceValidatedFallback() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+/* ---------- resolve refs ---------- */
+
+func TestTryGetEnvStringSliceResolveRefs(t *testing.T) {
+	tests := []struct {
+		name    string
+		set     bool
+		value   string
+		want    []string
+		wantErr string
+	}{
+		{name: "back-reference", set: true, value: "a,b,@a", want: []string{"a", "b", "a"}},
+		{name: "keyed entries", set: true, value: "db:postgres,replica:@db,@replica", want: []string{"db:postgres", "replica:postgres", "postgres"}},
+		{name: "no references", set: true, value: "x, y", want: []string{"x", "y"}},
+		{name: "unknown reference", set: true, value: "a,@c", wantErr: `element 1 "@c": unknown reference "c"`},
+		{name: "forward reference", set: true, value: "@b,b", wantErr: `element 0 "@b": unknown reference "b"`},
+		{name: "self reference", set: true, value: "x:@x", wantErr: `element 0 "x:@x": cyclic reference to "x"`},
+		{name: "missing", set: false, wantErr: "unable to find env variable"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.set {
+				t.Setenv("ENV_SLICE_REFS", tt.value)
+			}
+			got, err := goenv.TryGetEnvStringSliceResolveRefs("ENV_SLICE_REFS")
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("TryGetEnvStringSliceResolveRefs() error = %v, want it to contain %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("TryGetEnvStringSliceResolveRefs() failed: %v", err)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("TryGetEnvStringSliceResolveRefs() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+/* ---------- versioned ---------- */
+
+func TestGetEnvStringSliceVersioned(t *testing.T) {
+	migrate := func(list []string) []string {
+		out := make([]string, len(list))
+		for i, e := range list {
+			out[i] = "v2-" + e
+		}
+		return out
+	}
+
+	tests := []struct {
+		name     string
+		v2, v1   string
+		migrate  func([]string) []string
+		fallback []string
+		want     []string
+	}{
+		{name: "v2 present", v2: "a,b", v1: "old", migrate: migrate, want: []string{"a", "b"}},
+		{name: "v1 migrated", v1: "x, y", migrate: migrate, want: []string{"v2-x", "v2-y"}},
+		{name: "v2 no elements -> v1", v2: ",", v1: "x", migrate: migrate, want: []string{"v2-x"}},
+		{name: "nil migrate", v1: "x", want: []string{"x"}},
+		{name: "neither -> fallback", migrate: migrate, fallback: []string{"fb"}, want: []string{"fb"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("ENV_CONFIG_V2", tt.v2)
+			t.Setenv("ENV_CONFIG_V1", tt.v1)
+			got := goenv.GetEnvStringSliceVersioned("ENV_CONFIG_V2", "ENV_CONFIG_V1", tt.migrate, tt.fallback)
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("GetEnvStringSliceVersioned() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+/* ---------- byte budget ---------- */
+
+func TestTryGetEnvStringSlicesBudget(t *testing.T) {
+	t.Setenv("ENV_BUDGET_A", "a,b,c")   // 5 bytes
+	t.Setenv("ENV_BUDGET_B", "dd, ee")  // 6 bytes
+	t.Setenv("ENV_BUDGET_EMPTY", ",,,") // no elements, costs nothing
+	keys := []string{"ENV_BUDGET_A", "ENV_BUDGET_EMPTY", "ENV_BUDGET_B", "ENV_BUDGET_MISSING"}
+
+	t.Run("within budget", func(t *testing.T) {
+		got, err := goenv.TryGetEnvStringSlicesBudget(keys, 11)
+		if err != nil {
+			t.Fatalf("TryGetEnvStringSlicesBudget() failed: %v", err)
+		}
+		if len(got) != 2 || !slices.Equal(got["ENV_BUDGET_A"], []string{"a", "b", "c"}) || !slices.Equal(got["ENV_BUDGET_B"], []string{"dd", "ee"}) {
+			t.Errorf("TryGetEnvStringSlicesBudget() = %q", got)
+		}
+	})
+
+	t.Run("over budget", func(t *testing.T) {
+		got, err := goenv.TryGetEnvStringSlicesBudget(keys, 10)
+		if err == nil || !strings.Contains(err.Error(), "ENV_BUDGET_B exceeds byte budget: 11 bytes total, limit 10") {
+			t.Fatalf("TryGetEnvStringSlicesBudget() error = %v, want budget error for ENV_BUDGET_B", err)
+		}
+		if got != nil {
+			t.Errorf("TryGetEnvStringSlicesBudget() = %q on error, want nil", got)
+		}
+	})
+
+	t.Run("each key read once", func(t *testing.T) {
+		trackForTest(t)
+		if _, err := goenv.TryGetEnvStringSlicesBudget(keys, 11); err != nil {
+			t.Fatalf("TryGetEnvStringSlicesBudget() failed: %v", err)
+		}
+		if got := len(goenv.AccessLog()); got != len(keys) {
+			t.Errorf("len(AccessLog()) = %d, want %d", got, len(keys))
+		}
+	})
+}
+
+/* ---------- conditional ---------- */
+
+func TestGetEnvStringSliceConditional(t *testing.T) {
+	tests := []struct {
+		name     string
+		set      bool
+		value    string
+		flag     string
+		fallback []string
+		want     []string
+	}{
+		{name: "flag on", set: true, value: "a, b", flag: "true", want: []string{"A", "B"}},
+		{name: "flag off", set: true, value: "a, b", flag: "false", want: []string{"a", "b"}},
+		{name: "flag unset", set: true, value: "a", flag: "", want: []string{"a"}},
+		{name: "flag invalid", set: true, value: "a", flag: "yes please", want: []string{"a"}},
+		{name: "fallback untransformed", set: false, flag: "true", fallback: []string{"x"}, want: []string{"x"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.set {
+				t.Setenv("ENV_SLICE_TAGS", tt.value)
+			}
+			t.Setenv("ENV_SLICE_UPPERCASE", tt.flag)
+			got := goenv.GetEnvStringSliceConditional("ENV_SLICE_TAGS", "ENV_SLICE_UPPERCASE", strings.ToUpper, tt.fallback)
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("GetEnvStringSliceConditional() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
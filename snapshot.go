@@ -0,0 +1,69 @@
+package goenv
+
+import (
+	"fmt"
+	"os"
+	"strings"
+)
+
+// Snapshot captures the whole process environment and returns a function that
+// restores it exactly: variables added since are unset, and changed or removed ones
+// get their captured values back. It complements testing.T.Setenv for setup done
+// outside a test, e.g. in TestMain:
+//
+//	restore := goenv.Snapshot()
+//	defer restore()
+func Snapshot() func() {
+	saved := environMap()
+	return func() {
+		for k := range environMap() {
+			if _, ok := saved[k]; !ok {
+				os.Unsetenv(k)
+			}
+		}
+		for k, v := range saved {
+			os.Setenv(k, v)
+		}
+	}
+}
+
+// SetMany sets every variable in vars and returns a function that restores their
+// previous state, unsetting those that did not exist before. Other variables are left
+// alone. It panics if a variable cannot be set, after restoring any it already set.
+func SetMany(vars map[string]string) func() {
+	type prior struct {
+		value string
+		ok    bool
+	}
+	saved := make(map[string]prior, len(vars))
+	restore := func() {
+		for k, p := range saved {
+			if p.ok {
+				os.Setenv(k, p.value)
+			} else {
+				os.Unsetenv(k)
+			}
+		}
+	}
+
+	for k, v := range vars {
+		old, ok := os.LookupEnv(k)
+		if err := os.Setenv(k, v); err != nil {
+			restore()
+			panic(fmt.Errorf("unable to set env variable with key %s: %w", k, err))
+		}
+		saved[k] = prior{value: old, ok: ok}
+	}
+	return restore
+}
+
+// environMap returns the process environment keyed by variable name.
+func environMap() map[string]string {
+	env := os.Environ()
+	m := make(map[string]string, len(env))
+	for _, kv := range env {
+		k, v, _ := strings.Cut(kv, "=")
+		m[k] = v
+	}
+	return m
+}
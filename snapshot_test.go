@@ -0,0 +1,89 @@
+package goenv_test
+
+import (
+	"os"
+	"slices"
+	"testing"
+
+	"github.com/battlej07/goenv"
+)
+
+/* ---------- snapshot ---------- */
+
+func TestSnapshot(t *testing.T) {
+	unsetForTest(t, "SNAP_NEW", "SNAP_REMOVED", "SNAP_CHANGED", "SNAP_EMPTY")
+	t.Setenv("SNAP_REMOVED", "gone")
+	t.Setenv("SNAP_CHANGED", "before")
+	t.Setenv("SNAP_EMPTY", "")
+	before := os.Environ()
+	slices.Sort(before)
+
+	restore := goenv.Snapshot()
+	os.Setenv("SNAP_NEW", "added")
+	os.Unsetenv("SNAP_REMOVED")
+	os.Setenv("SNAP_CHANGED", "after")
+	os.Unsetenv("SNAP_EMPTY")
+	restore()
+
+	after := os.Environ()
+	slices.Sort(after)
+	if !slices.Equal(after, before) {
+		t.Errorf("environment after restore differs from the snapshot")
+	}
+	if _, ok := os.LookupEnv("SNAP_NEW"); ok {
+		t.Error("SNAP_NEW still set after restore")
+	}
+	if v, ok := os.LookupEnv("SNAP_EMPTY"); !ok || v != "" {
+		t.Errorf("SNAP_EMPTY = %q, %v, want set to empty", v, ok)
+	}
+	if v, _ := os.LookupEnv("SNAP_CHANGED"); v != "before" {
+		t.Errorf("SNAP_CHANGED = %q, want before", v)
+	}
+	if v, _ := os.LookupEnv("SNAP_REMOVED"); v != "gone" {
+		t.Errorf("SNAP_REMOVED = %q, want gone", v)
+	}
+}
+
+func TestSetMany(t *testing.T) {
+	unsetForTest(t, "SETMANY_NEW", "SETMANY_OLD", "SETMANY_EMPTY", "SETMANY_OTHER")
+	t.Setenv("SETMANY_OLD", "old")
+	t.Setenv("SETMANY_EMPTY", "")
+
+	restore := goenv.SetMany(map[string]string{
+		"SETMANY_NEW":   "new",
+		"SETMANY_OLD":   "overwritten",
+		"SETMANY_EMPTY": "filled",
+	})
+	for k, want := range map[string]string{"SETMANY_NEW": "new", "SETMANY_OLD": "overwritten", "SETMANY_EMPTY": "filled"} {
+		if v, _ := os.LookupEnv(k); v != want {
+			t.Errorf("%s = %q after SetMany, want %q", k, v, want)
+		}
+	}
+
+	os.Setenv("SETMANY_OTHER", "untouched")
+	restore()
+
+	if _, ok := os.LookupEnv("SETMANY_NEW"); ok {
+		t.Error("SETMANY_NEW still set after restore, want unset")
+	}
+	if v, _ := os.LookupEnv("SETMANY_OLD"); v != "old" {
+		t.Errorf("SETMANY_OLD = %q after restore, want old", v)
+	}
+	if v, ok := os.LookupEnv("SETMANY_EMPTY"); !ok || v != "" {
+		t.Errorf("SETMANY_EMPTY = %q, %v after restore, want set to empty", v, ok)
+	}
+	if v, _ := os.LookupEnv("SETMANY_OTHER"); v != "untouched" {
+		t.Errorf("SETMANY_OTHER = %q, want it left alone", v)
+	}
+}
+
+func TestSetManyInvalidKey(t *testing.T) {
+	unsetForTest(t, "SETMANY_NEW")
+	func() {
+		defer expectPanic(t, true)()
+		goenv.SetMany(map[string]string{"SETMANY_NEW": "new", "": "bad"})
+	}()
+	if _, ok := os.LookupEnv("SETMANY_NEW"); ok {
+		t.Error("SETMANY_NEW still set after a failed SetMany, want it restored")
+	}
+}
@@ -0,0 +1,54 @@
+package goenv
+
+import "sync/atomic"
+
+var (
+	envHitCount   atomic.Uint64
+	fallbackCount atomic.Uint64
+)
+
+// Stats returns how many lookups made through the fallback-returning GetEnv* helpers
+// were served from the environment and how many returned the fallback instead.
+// TryGetEnv* and MustGetEnv* calls are not counted.
+func Stats() (envHits, fallbacks uint64) {
+	return envHitCount.Load(), fallbackCount.Load()
+}
+
+// ResetStats sets both counters reported by Stats back to zero.
+func ResetStats() {
+	envHitCount.Store(0)
+	fallbackCount.Store(0)
+}
+
+// recordHit counts a lookup served from the environment.
+func recordHit() {
+	envHitCount.Add(1)
+}
+
+// recordFallback counts a lookup of key that returned the fallback and marks it in
+// the access log.
+func recordFallback(key string) {
+	fallbackCount.Add(1)
+	markFallback(key)
+}
+
+// orFallback returns v if err is nil and fallback otherwise, recording the outcome
+// for key.
+func orFallback[T any](key string, v T, err error, fallback T) T {
+	if err != nil {
+		recordFallback(key)
+		return fallback
+	}
+	recordHit()
+	return v
+}
+
+// orFallbackFunc is like orFallback but calls fallback only when err is non-nil.
+func orFallbackFunc[T any](key string, v T, err error, fallback func() T) T {
+	if err != nil {
+		recordFallback(key)
+		return fallback()
+	}
+	recordHit()
+	return v
+}
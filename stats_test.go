@@ -0,0 +1,39 @@
+package goenv_test
+
+import (
+	"testing"
+
+	"github.com/battlej07/goenv"
+)
+
+/* ---------- stats ---------- */
+
+func TestStats(t *testing.T) {
+	goenv.ResetStats()
+	t.Cleanup(goenv.ResetStats)
+
+	t.Setenv("STATS_NAME", "app")
+	t.Setenv("STATS_PORT", "8080")
+	t.Setenv("STATS_BAD", "nope")
+	t.Setenv("STATS_LIST", "a,b")
+
+	_ = goenv.GetEnv("STATS_NAME", "x")
+	_ = goenv.GetEnvInt("STATS_PORT", 1)
+	_ = goenv.GetEnvStringLines("STATS_LIST", nil)
+	_ = goenv.GetEnv("STATS_MISSING", "x")
+	_ = goenv.GetEnvInt("STATS_BAD", 1)
+
+	// Try and Must helpers are not counted.
+	_, _ = goenv.TryGetEnv("STATS_NAME")
+	_ = goenv.MustGetEnv("STATS_NAME")
+
+	hits, fallbacks := goenv.Stats()
+	if hits != 3 || fallbacks != 2 {
+		t.Errorf("Stats() = (%d, %d), want (3, 2)", hits, fallbacks)
+	}
+
+	goenv.ResetStats()
+	if hits, fallbacks := goenv.Stats(); hits != 0 || fallbacks != 0 {
+		t.Errorf("Stats() after ResetStats = (%d, %d), want (0, 0)", hits, fallbacks)
+	}
+}
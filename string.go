@@ -0,0 +1,38 @@
+package goenv
+
+import "strings"
+
+// GetEnvStringCharset returns the value of the environment variable named by key
+// if every rune in it appears in allowed. If the variable is unset, empty, or
+// contains a disallowed character, it returns fallback.
+func GetEnvStringCharset(key, allowed, fallback string) string {
+	v, err := TryGetEnvStringCharset(key, allowed)
+	return orFallback(key, v, err, fallback)
+}
+
+// TryGetEnvStringCharset returns the value of the environment variable named by key
+// if every rune in it appears in allowed. It returns an error naming the first
+// offending character if the value contains one, or if the variable is unset or empty.
+func TryGetEnvStringCharset(key, allowed string) (string, error) {
+	v, err := TryGetEnv(key)
+	if err != nil {
+		return "", err
+	}
+	for i, r := range v {
+		if !strings.ContainsRune(allowed, r) {
+			return "", parseErrorf(key, "value %q contains disallowed character %q at byte %d", v, r, i)
+		}
+	}
+	return v, nil
+}
+
+// MustGetEnvStringCharset returns the value of the environment variable named by key
+// if every rune in it appears in allowed. It panics if the variable is unset, empty,
+// or contains a disallowed character.
+func MustGetEnvStringCharset(key, allowed string) string {
+	v, err := TryGetEnvStringCharset(key, allowed)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
@@ -0,0 +1,94 @@
+package goenv_test
+
+import (
+	"testing"
+
+	"github.com/battlej07/goenv"
+)
+
+/* ---------- string (charset) ---------- */
+
+const identChars = "abcdefghijklmnopqrstuvwxyz0123456789-_"
+
+func TestGetEnvStringCharset(t *testing.T) {
+	tests := []struct {
+		name     string
+		key      string
+		set      bool
+		value    string
+		fallback string
+		want     string
+	}{
+		{name: "all allowed", key: "ENV_CHARSET", set: true, value: "svc-01_a", fallback: "fb", want: "svc-01_a"},
+		{name: "disallowed -> fallback", key: "ENV_CHARSET", set: true, value: "svc 01", fallback: "fb", want: "fb"},
+		{name: "empty -> fallback", key: "ENV_CHARSET", set: true, value: "", fallback: "fb", want: "fb"},
+		{name: "missing -> fallback", key: "ENV_CHARSET", set: false, fallback: "fb", want: "fb"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.set {
+				t.Setenv(tt.key, tt.value)
+			}
+			if got := goenv.GetEnvStringCharset(tt.key, identChars, tt.fallback); got != tt.want {
+				t.Errorf("GetEnvStringCharset() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTryGetEnvStringCharset(t *testing.T) {
+	tests := []struct {
+		name    string
+		key     string
+		set     bool
+		value   string
+		want    string
+		wantErr bool
+	}{
+		{name: "all allowed", key: "TRY_CHARSET", set: true, value: "tenant_42", want: "tenant_42"},
+		{name: "disallowed -> err", key: "TRY_CHARSET", set: true, value: "tenant/42", wantErr: true},
+		{name: "multibyte disallowed -> err", key: "TRY_CHARSET", set: true, value: "café", wantErr: true},
+		{name: "empty -> err", key: "TRY_CHARSET", set: true, value: "", wantErr: true},
+		{name: "missing -> err", key: "TRY_CHARSET", set: false, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.set {
+				t.Setenv(tt.key, tt.value)
+			}
+			got, err := goenv.TryGetEnvStringCharset(tt.key, identChars)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("TryGetEnvStringCharset() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && got != tt.want {
+				t.Errorf("TryGetEnvStringCharset() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMustGetEnvStringCharset(t *testing.T) {
+	tests := []struct {
+		name      string
+		key       string
+		set       bool
+		value     string
+		want      string
+		wantPanic bool
+	}{
+		{name: "ok", key: "MUST_CHARSET", set: true, value: "abc", want: "abc"},
+		{name: "disallowed -> panic", key: "MUST_CHARSET", set: true, value: "ab$", wantPanic: true},
+		{name: "missing -> panic", key: "MUST_CHARSET", set: false, wantPanic: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.set {
+				t.Setenv(tt.key, tt.value)
+			}
+			defer expectPanic(t, tt.wantPanic)()
+			if got := goenv.MustGetEnvStringCharset(tt.key, identChars); got != tt.want {
+				t.Errorf("MustGetEnvStringCharset() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
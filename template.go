@@ -0,0 +1,48 @@
+package goenv
+
+import (
+	"os"
+	"regexp"
+	"strings"
+	"text/template"
+)
+
+// envRefPattern matches a shell-style ${NAME} reference to an environment variable.
+var envRefPattern = regexp.MustCompile(`\$\{([A-Za-z_][A-Za-z0-9_]*)\}`)
+
+// TryGetEnvTemplatedSlice returns the comma-separated elements of the environment
+// variable named by key, each rendered as a text/template against the environment,
+// e.g. URLS="http://${HOST}/a,http://{{.HOST}}/b" with HOST=example.com yields
+// ["http://example.com/a", "http://example.com/b"]. A ${NAME} reference is shorthand
+// for {{.NAME}}; other uses of '$', such as $NAME, are left as is. Referencing an
+// unset variable is an error rather than rendering "<no value>". It returns the first parse or render
+// error, naming the element, or an error if the variable is unset, empty, or contains
+// no elements.
+func TryGetEnvTemplatedSlice(key string) ([]string, error) {
+	elems, err := TryGetEnvStringSlice(key)
+	if err != nil {
+		return nil, err
+	}
+
+	env := make(map[string]string)
+	for _, kv := range os.Environ() {
+		k, v, _ := strings.Cut(kv, "=")
+		env[k] = v
+	}
+
+	out := make([]string, len(elems))
+	var b strings.Builder
+	for i, e := range elems {
+		src := envRefPattern.ReplaceAllString(e, "{{.${1}}}")
+		tmpl, err := template.New(key).Option("missingkey=error").Parse(src)
+		if err != nil {
+			return nil, parseErrorf(key, "element %d %q is not a valid template: %w", i, e, err)
+		}
+		b.Reset()
+		if err := tmpl.Execute(&b, env); err != nil {
+			return nil, parseErrorf(key, "element %d %q: %w", i, e, err)
+		}
+		out[i] = b.String()
+	}
+	return out, nil
+}
@@ -0,0 +1,53 @@
+package goenv_test
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/battlej07/goenv"
+)
+
+/* ---------- templated slice ---------- */
+
+func TestTryGetEnvTemplatedSlice(t *testing.T) {
+	t.Setenv("TMPL_HOST", "example.com")
+	t.Setenv("TMPL_PORT", "8080")
+
+	tests := []struct {
+		name    string
+		set     bool
+		value   string
+		want    []string
+		wantErr string
+	}{
+		{name: "env references", set: true, value: "http://{{.TMPL_HOST}}/a, http://{{.TMPL_HOST}}:{{.TMPL_PORT}}/b", want: []string{"http://example.com/a", "http://example.com:8080/b"}},
+		{name: "shell-style references", set: true, value: "http://${TMPL_HOST}/a,http://${TMPL_HOST}:{{.TMPL_PORT}}/b", want: []string{"http://example.com/a", "http://example.com:8080/b"}},
+		{name: "other dollar forms untouched", set: true, value: "$TMPL_HOST,${1x}", want: []string{"$TMPL_HOST", "${1x}"}},
+		{name: "unset shell-style reference", set: true, value: "${TMPL_MISSING}", wantErr: `element 0 "${TMPL_MISSING}"`},
+		{name: "plain elements", set: true, value: "a,b", want: []string{"a", "b"}},
+		{name: "invalid template", set: true, value: "ok,{{.TMPL_HOST", wantErr: `element 1 "{{.TMPL_HOST" is not a valid template`},
+		{name: "unset reference", set: true, value: "{{.TMPL_MISSING}}", wantErr: `element 0 "{{.TMPL_MISSING}}"`},
+		{name: "missing", set: false, wantErr: "unable to find env variable"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.set {
+				t.Setenv("ENV_TEMPLATED", tt.value)
+			}
+			got, err := goenv.TryGetEnvTemplatedSlice("ENV_TEMPLATED")
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("TryGetEnvTemplatedSlice() error = %v, want it to contain %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("TryGetEnvTemplatedSlice() failed: %v", err)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("TryGetEnvTemplatedSlice() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
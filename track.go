@@ -0,0 +1,91 @@
+package goenv
+
+import (
+	"os"
+	"reflect"
+	"sync"
+	"sync/atomic"
+)
+
+// Access describes one read of an environment variable recorded while tracking is
+// enabled.
+type Access struct {
+	Key          string // name of the environment variable
+	Type         string // Go type the value is parsed into, e.g. "int" for GetEnvInt
+	UsedFallback bool   // the getter returned its fallback instead of the value
+	Found        bool   // the variable was set and non-empty; for GetEnvFlagPresent, set
+}
+
+var (
+	trackingEnabled atomic.Bool
+	accessMu        sync.Mutex
+	accessLog       []Access
+)
+
+// EnableTracking starts recording every variable read through the GetEnv*, TryGetEnv*
+// and MustGetEnv* functions, for example to document or audit the configuration an
+// application uses. Every variable a getter looks up is recorded, including condition
+// and alternative keys and values served by a Cache; when a getter returns its
+// fallback, its last read of the key is marked as such. Getters that scan the whole
+// environment, such as GetEnvMatchingKeys, are not recorded.
+func EnableTracking() {
+	trackingEnabled.Store(true)
+}
+
+// DisableTracking stops recording reads. Entries already recorded are kept.
+func DisableTracking() {
+	trackingEnabled.Store(false)
+}
+
+// AccessLog returns a copy of the reads recorded since tracking was enabled or the log
+// was last reset, oldest first. It is safe to call concurrently with getters.
+func AccessLog() []Access {
+	accessMu.Lock()
+	defer accessMu.Unlock()
+	out := make([]Access, len(accessLog))
+	copy(out, accessLog)
+	return out
+}
+
+// ResetAccessLog discards all recorded reads.
+func ResetAccessLog() {
+	accessMu.Lock()
+	defer accessMu.Unlock()
+	accessLog = nil
+}
+
+// lookupEnv returns the value of key like os.Getenv, recording the read as a
+// lookup of typ, the Go type the caller parses the value into.
+func lookupEnv(key, typ string) string {
+	v := os.Getenv(key)
+	if trackingEnabled.Load() {
+		appendAccess(Access{Key: key, Type: typ, Found: v != ""})
+	}
+	return v
+}
+
+// markFallback flags the most recent read of key as having returned the fallback.
+func markFallback(key string) {
+	if !trackingEnabled.Load() {
+		return
+	}
+	accessMu.Lock()
+	defer accessMu.Unlock()
+	for i := len(accessLog) - 1; i >= 0; i-- {
+		if accessLog[i].Key == key && !accessLog[i].UsedFallback {
+			accessLog[i].UsedFallback = true
+			return
+		}
+	}
+}
+
+func appendAccess(a Access) {
+	accessMu.Lock()
+	defer accessMu.Unlock()
+	accessLog = append(accessLog, a)
+}
+
+// typeName returns the name used for T in the access log, e.g. "[]int".
+func typeName[T any]() string {
+	return reflect.TypeFor[T]().String()
+}
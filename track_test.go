@@ -0,0 +1,187 @@
+package goenv_test
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/battlej07/goenv"
+)
+
+/* ---------- access tracking ---------- */
+
+// trackForTest enables tracking with an empty log for the duration of the test.
+func trackForTest(t *testing.T) {
+	t.Helper()
+	goenv.ResetAccessLog()
+	goenv.EnableTracking()
+	t.Cleanup(func() {
+		goenv.DisableTracking()
+		goenv.ResetAccessLog()
+	})
+}
+
+func TestAccessLog(t *testing.T) {
+	trackForTest(t)
+	unsetForTest(t, "TRACK_MISSING")
+	t.Setenv("TRACK_NAME", "app")
+	t.Setenv("TRACK_PORT", "8080")
+	t.Setenv("TRACK_BAD", "nope")
+	t.Setenv("TRACK_LIST", "a,b")
+	t.Setenv("TRACK_TIMEOUT", "5s")
+
+	_ = goenv.GetEnv("TRACK_NAME", "x")
+	_ = goenv.GetEnvInt("TRACK_PORT", 1)
+	_ = goenv.GetEnvInt("TRACK_BAD", 1)
+	_ = goenv.GetEnv("TRACK_MISSING", "x")
+	_ = goenv.GetEnvStringSlice("TRACK_LIST", nil)
+	_, _ = goenv.TryGetEnvInt("TRACK_MISSING")
+	_ = goenv.MustGetEnvDuration("TRACK_TIMEOUT")
+	_, _ = goenv.TryGetEnvIntRange("TRACK_PORT", 1, 65535)
+
+	want := []goenv.Access{
+		{Key: "TRACK_NAME", Type: "string", Found: true},
+		{Key: "TRACK_PORT", Type: "int", Found: true},
+		{Key: "TRACK_BAD", Type: "int", UsedFallback: true, Found: true},
+		{Key: "TRACK_MISSING", Type: "string", UsedFallback: true},
+		{Key: "TRACK_LIST", Type: "[]string", Found: true},
+		{Key: "TRACK_MISSING", Type: "int"},
+		{Key: "TRACK_TIMEOUT", Type: "time.Duration", Found: true},
+		{Key: "TRACK_PORT", Type: "int", Found: true},
+	}
+	if got := goenv.AccessLog(); !slices.Equal(got, want) {
+		t.Errorf("AccessLog() =\n%+v\nwant\n%+v", got, want)
+	}
+}
+
+func TestAccessLogEveryKey(t *testing.T) {
+	tests := []struct {
+		name string
+		env  map[string]string
+		read func()
+		want []goenv.Access
+	}{
+		{
+			name: "condition key",
+			env:  map[string]string{"TRACK_LIST": "a,b", "TRACK_UPPER": "true"},
+			read: func() { _ = goenv.GetEnvStringSliceConditional("TRACK_LIST", "TRACK_UPPER", strings.ToUpper, nil) },
+			want: []goenv.Access{
+				{Key: "TRACK_LIST", Type: "[]string", Found: true},
+				{Key: "TRACK_UPPER", Type: "bool", Found: true},
+			},
+		},
+		{
+			name: "indexed alternative",
+			env:  map[string]string{"TRACK_HOST_0": "a"},
+			read: func() { _ = goenv.GetEnvStringSliceOrIndexed("TRACK_HOSTS", "TRACK_HOST_", nil) },
+			want: []goenv.Access{
+				{Key: "TRACK_HOSTS", Type: "[]string"},
+				{Key: "TRACK_HOST_0", Type: "string", Found: true},
+				{Key: "TRACK_HOST_1", Type: "string"},
+			},
+		},
+		{
+			name: "fallback marks the last read",
+			read: func() { _ = goenv.GetEnvStringSliceOrIndexed("TRACK_HOSTS", "TRACK_HOST_", nil) },
+			want: []goenv.Access{
+				{Key: "TRACK_HOSTS", Type: "[]string", UsedFallback: true},
+				{Key: "TRACK_HOST_0", Type: "string"},
+			},
+		},
+		{
+			name: "generic slice",
+			env:  map[string]string{"TRACK_PORTS": "1,2"},
+			read: func() { _ = goenv.GetEnvSliceOr("TRACK_PORTS", ",", strconv.Atoi, nil) },
+			want: []goenv.Access{{Key: "TRACK_PORTS", Type: "[]int", Found: true}},
+		},
+		{
+			name: "present but empty flag",
+			env:  map[string]string{"TRACK_DEBUG": ""},
+			read: func() { _ = goenv.GetEnvFlagPresent("TRACK_DEBUG") },
+			want: []goenv.Access{{Key: "TRACK_DEBUG", Type: "bool", Found: true}},
+		},
+		{
+			name: "cache hit",
+			env:  map[string]string{"TRACK_PORT": "8080"},
+			read: func() {
+				c := goenv.NewCache()
+				_ = c.GetEnvInt("TRACK_PORT", 1)
+				_ = c.GetEnvInt("TRACK_PORT", 1)
+				_ = c.GetEnvDuration("TRACK_MISSING", time.Second)
+				_ = c.GetEnvDuration("TRACK_MISSING", time.Second)
+			},
+			want: []goenv.Access{
+				{Key: "TRACK_PORT", Type: "int", Found: true},
+				{Key: "TRACK_PORT", Type: "int", Found: true},
+				{Key: "TRACK_MISSING", Type: "time.Duration", UsedFallback: true},
+				{Key: "TRACK_MISSING", Type: "time.Duration", UsedFallback: true},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			trackForTest(t)
+			unsetForTest(t, "TRACK_LIST", "TRACK_UPPER", "TRACK_HOSTS", "TRACK_HOST_0", "TRACK_HOST_1", "TRACK_PORTS", "TRACK_PORT", "TRACK_MISSING", "TRACK_DEBUG")
+			for k, v := range tt.env {
+				t.Setenv(k, v)
+			}
+			tt.read()
+			if got := goenv.AccessLog(); !slices.Equal(got, tt.want) {
+				t.Errorf("AccessLog() =\n%+v\nwant\n%+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAccessLogDisabled(t *testing.T) {
+	trackForTest(t)
+	goenv.DisableTracking()
+	t.Setenv("TRACK_NAME", "app")
+
+	_ = goenv.GetEnv("TRACK_NAME", "x")
+	_, _ = goenv.TryGetEnv("TRACK_NAME")
+	if got := goenv.AccessLog(); len(got) != 0 {
+		t.Errorf("AccessLog() = %+v, want empty while tracking is disabled", got)
+	}
+
+	goenv.EnableTracking()
+	_ = goenv.GetEnv("TRACK_NAME", "x")
+	log := goenv.AccessLog()
+	if len(log) != 1 {
+		t.Fatalf("AccessLog() = %+v, want 1 entry", log)
+	}
+	log[0].Key = "changed"
+	if goenv.AccessLog()[0].Key != "TRACK_NAME" {
+		t.Error("AccessLog() returned a slice sharing the internal log")
+	}
+
+	goenv.ResetAccessLog()
+	if got := goenv.AccessLog(); len(got) != 0 {
+		t.Errorf("AccessLog() after ResetAccessLog = %+v, want empty", got)
+	}
+}
+
+func TestAccessLogConcurrent(t *testing.T) {
+	trackForTest(t)
+	t.Setenv("TRACK_PORT", "8080")
+
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range 100 {
+				_ = goenv.GetEnvInt("TRACK_PORT", 1)
+				_ = goenv.AccessLog()
+			}
+		}()
+	}
+	wg.Wait()
+	if got := len(goenv.AccessLog()); got != 800 {
+		t.Errorf("len(AccessLog()) = %d, want 800", got)
+	}
+}
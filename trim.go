@@ -0,0 +1,141 @@
+package goenv
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// The Trimmed getters below apply strings.TrimSpace to the raw value before parsing,
+// so "8080\n" reads as 8080. Trimming is opt-in: every other getter sees the value
+// exactly as set. A value that is empty after trimming counts as unset.
+
+// GetEnvTrimmed returns the value of the environment variable named by key with
+// leading and trailing whitespace removed. If the trimmed value is empty, it returns
+// fallback.
+func GetEnvTrimmed(key, fallback string) string {
+	v, err := TryGetEnvTrimmed(key)
+	return orFallback(key, v, err, fallback)
+}
+
+// GetEnvIntTrimmed is like GetEnvInt but trims surrounding whitespace before parsing.
+func GetEnvIntTrimmed(key string, fallback int) int {
+	v, err := TryGetEnvIntTrimmed(key)
+	return orFallback(key, v, err, fallback)
+}
+
+// GetEnvFloat64Trimmed is like GetEnvFloat64 but trims surrounding whitespace before
+// parsing.
+func GetEnvFloat64Trimmed(key string, fallback float64) float64 {
+	v, err := TryGetEnvFloat64Trimmed(key)
+	return orFallback(key, v, err, fallback)
+}
+
+// GetEnvBoolTrimmed is like GetEnvBool but trims surrounding whitespace before parsing.
+func GetEnvBoolTrimmed(key string, fallback bool) bool {
+	v, err := TryGetEnvBoolTrimmed(key)
+	return orFallback(key, v, err, fallback)
+}
+
+// GetEnvDurationTrimmed is like GetEnvDuration but trims surrounding whitespace before
+// parsing.
+func GetEnvDurationTrimmed(key string, fallback time.Duration) time.Duration {
+	v, err := TryGetEnvDurationTrimmed(key)
+	return orFallback(key, v, err, fallback)
+}
+
+// TryGetEnvTrimmed returns the value of the environment variable named by key with
+// leading and trailing whitespace removed. It returns an error if the variable is
+// unset or empty after trimming.
+func TryGetEnvTrimmed(key string) (string, error) {
+	return tryGetEnvTrimmed(key, func(s string) (string, error) { return s, nil }, "string")
+}
+
+// TryGetEnvIntTrimmed is like TryGetEnvInt but trims surrounding whitespace before
+// parsing.
+func TryGetEnvIntTrimmed(key string) (int, error) {
+	return tryGetEnvTrimmed(key, strconv.Atoi, "an integer")
+}
+
+// TryGetEnvFloat64Trimmed is like TryGetEnvFloat64 but trims surrounding whitespace
+// before parsing.
+func TryGetEnvFloat64Trimmed(key string) (float64, error) {
+	return tryGetEnvTrimmed(key, func(s string) (float64, error) { return strconv.ParseFloat(s, 64) }, "float64")
+}
+
+// TryGetEnvBoolTrimmed is like TryGetEnvBool but trims surrounding whitespace before
+// parsing.
+func TryGetEnvBoolTrimmed(key string) (bool, error) {
+	return tryGetEnvTrimmed(key, strconv.ParseBool, "bool")
+}
+
+// TryGetEnvDurationTrimmed is like TryGetEnvDuration but trims surrounding whitespace
+// before parsing.
+func TryGetEnvDurationTrimmed(key string) (time.Duration, error) {
+	return tryGetEnvTrimmed(key, time.ParseDuration, "duration")
+}
+
+// MustGetEnvTrimmed returns the value of the environment variable named by key with
+// leading and trailing whitespace removed. It panics if the trimmed value is empty.
+func MustGetEnvTrimmed(key string) string {
+	v, err := TryGetEnvTrimmed(key)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// MustGetEnvIntTrimmed is like MustGetEnvInt but trims surrounding whitespace before
+// parsing.
+func MustGetEnvIntTrimmed(key string) int {
+	v, err := TryGetEnvIntTrimmed(key)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// MustGetEnvFloat64Trimmed is like MustGetEnvFloat64 but trims surrounding whitespace
+// before parsing.
+func MustGetEnvFloat64Trimmed(key string) float64 {
+	v, err := TryGetEnvFloat64Trimmed(key)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// MustGetEnvBoolTrimmed is like MustGetEnvBool but trims surrounding whitespace before
+// parsing.
+func MustGetEnvBoolTrimmed(key string) bool {
+	v, err := TryGetEnvBoolTrimmed(key)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// MustGetEnvDurationTrimmed is like MustGetEnvDuration but trims surrounding whitespace
+// before parsing.
+func MustGetEnvDurationTrimmed(key string) time.Duration {
+	v, err := TryGetEnvDurationTrimmed(key)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// tryGetEnvTrimmed trims the value of key and converts it with parse, describing the
+// target type as what in errors.
+func tryGetEnvTrimmed[T any](key string, parse func(string) (T, error), what string) (T, error) {
+	var zero T
+	v := strings.TrimSpace(lookupEnv(key, typeName[T]()))
+	if v == "" {
+		return zero, notFoundError(key)
+	}
+	t, err := parse(v)
+	if err != nil {
+		return zero, parseErrorf(key, "unable to convert %q to %s: %w", v, what, err)
+	}
+	return t, nil
+}
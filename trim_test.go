@@ -0,0 +1,113 @@
+package goenv_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/battlej07/goenv"
+)
+
+/* ---------- trimmed ---------- */
+
+func TestTryGetEnvTrimmed(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		want  string
+	}{
+		{name: "trailing newline", value: "secret\n", want: "secret"},
+		{name: "leading and trailing spaces", value: "  secret  ", want: "secret"},
+		{name: "CRLF", value: "secret\r\n", want: "secret"},
+		{name: "embedded newline kept", value: " line1\nline2 \n", want: "line1\nline2"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("ENV_TRIMMED", tt.value)
+			got, err := goenv.TryGetEnvTrimmed("ENV_TRIMMED")
+			if err != nil || got != tt.want {
+				t.Errorf("TryGetEnvTrimmed() = %q, %v, want %q", got, err, tt.want)
+			}
+		})
+	}
+
+	t.Setenv("ENV_TRIMMED", " \n\t")
+	if _, err := goenv.TryGetEnvTrimmed("ENV_TRIMMED"); err == nil {
+		t.Error("TryGetEnvTrimmed() succeeded for a whitespace-only value")
+	}
+}
+
+func TestTryGetEnvIntTrimmed(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		want    int
+		wantErr bool
+	}{
+		{name: "trailing newline", value: "8080\n", want: 8080},
+		{name: "surrounding spaces", value: "  -3 ", want: -3},
+		{name: "tabs and CRLF", value: "\t42\r\n", want: 42},
+		{name: "embedded newline", value: "80\n80", wantErr: true},
+		{name: "embedded space", value: "80 80", wantErr: true},
+		{name: "whitespace only", value: " \n ", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("ENV_INT_TRIMMED", tt.value)
+			got, err := goenv.TryGetEnvIntTrimmed("ENV_INT_TRIMMED")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("TryGetEnvIntTrimmed() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && got != tt.want {
+				t.Errorf("TryGetEnvIntTrimmed() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTrimmedOptIn(t *testing.T) {
+	t.Setenv("ENV_TRIMMED", "8080\n")
+	if got := goenv.GetEnvInt("ENV_TRIMMED", 1); got != 1 {
+		t.Errorf("GetEnvInt() = %d, want fallback 1 since only Trimmed variants trim", got)
+	}
+	if got := goenv.GetEnvIntTrimmed("ENV_TRIMMED", 1); got != 8080 {
+		t.Errorf("GetEnvIntTrimmed() = %d, want 8080", got)
+	}
+	if got := goenv.GetEnvTrimmed("ENV_TRIMMED", "x"); got != "8080" {
+		t.Errorf("GetEnvTrimmed() = %q, want 8080", got)
+	}
+
+	t.Setenv("ENV_TRIMMED", " 0.5\n")
+	if got := goenv.GetEnvFloat64Trimmed("ENV_TRIMMED", 1); got != 0.5 {
+		t.Errorf("GetEnvFloat64Trimmed() = %v, want 0.5", got)
+	}
+	t.Setenv("ENV_TRIMMED", "true\n")
+	if got := goenv.GetEnvBoolTrimmed("ENV_TRIMMED", false); !got {
+		t.Error("GetEnvBoolTrimmed() = false, want true")
+	}
+	t.Setenv("ENV_TRIMMED", " 5s ")
+	if got := goenv.GetEnvDurationTrimmed("ENV_TRIMMED", time.Second); got != 5*time.Second {
+		t.Errorf("GetEnvDurationTrimmed() = %v, want 5s", got)
+	}
+	if got := goenv.GetEnvIntTrimmed("ENV_TRIMMED", 7); got != 7 {
+		t.Errorf("GetEnvIntTrimmed() = %d, want fallback 7", got)
+	}
+}
+
+func TestMustGetEnvTrimmed(t *testing.T) {
+	t.Setenv("ENV_TRIMMED", " 1s\n")
+	func() {
+		defer expectPanic(t, false)()
+		goenv.MustGetEnvTrimmed("ENV_TRIMMED")
+		goenv.MustGetEnvDurationTrimmed("ENV_TRIMMED")
+	}()
+	for name, fn := range map[string]func(string){
+		"int":   func(k string) { goenv.MustGetEnvIntTrimmed(k) },
+		"float": func(k string) { goenv.MustGetEnvFloat64Trimmed(k) },
+		"bool":  func(k string) { goenv.MustGetEnvBoolTrimmed(k) },
+	} {
+		t.Run(name, func(t *testing.T) {
+			defer expectPanic(t, true)()
+			fn("ENV_TRIMMED")
+		})
+	}
+}
@@ -0,0 +1,160 @@
+package goenv
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+// GetEnvUint returns the uint value of the environment variable named by key.
+// If the variable is unset, empty, negative, cannot be parsed, or overflows uint,
+// it returns fallback.
+func GetEnvUint(key string, fallback uint) uint {
+	v, err := TryGetEnvUint(key)
+	return orFallback(key, v, err, fallback)
+}
+
+// GetEnvUint64 returns the uint64 value of the environment variable named by key.
+// If the variable is unset, empty, negative, cannot be parsed, or overflows uint64,
+// it returns fallback.
+func GetEnvUint64(key string, fallback uint64) uint64 {
+	v, err := TryGetEnvUint64(key)
+	return orFallback(key, v, err, fallback)
+}
+
+// GetEnvUint32 returns the uint32 value of the environment variable named by key.
+// If the variable is unset, empty, negative, cannot be parsed, or overflows uint32,
+// it returns fallback.
+func GetEnvUint32(key string, fallback uint32) uint32 {
+	v, err := TryGetEnvUint32(key)
+	return orFallback(key, v, err, fallback)
+}
+
+// GetEnvUint16 returns the uint16 value of the environment variable named by key.
+// If the variable is unset, empty, negative, cannot be parsed, or overflows uint16,
+// it returns fallback.
+func GetEnvUint16(key string, fallback uint16) uint16 {
+	v, err := TryGetEnvUint16(key)
+	return orFallback(key, v, err, fallback)
+}
+
+// GetEnvUint8 returns the uint8 value of the environment variable named by key.
+// If the variable is unset, empty, negative, cannot be parsed, or overflows uint8,
+// it returns fallback.
+func GetEnvUint8(key string, fallback uint8) uint8 {
+	v, err := TryGetEnvUint8(key)
+	return orFallback(key, v, err, fallback)
+}
+
+// TryGetEnvUint returns the uint value of the environment variable named by key.
+// It returns an error if the variable is unset, empty, negative, cannot be parsed,
+// or overflows uint.
+func TryGetEnvUint(key string) (uint, error) {
+	u, err := tryGetEnvUintBits(key, 0)
+	return uint(u), err
+}
+
+// TryGetEnvUint64 returns the uint64 value of the environment variable named by key.
+// It returns an error if the variable is unset, empty, negative, cannot be parsed,
+// or overflows uint64.
+func TryGetEnvUint64(key string) (uint64, error) {
+	u, err := tryGetEnvUintBits(key, 64)
+	return u, err
+}
+
+// TryGetEnvUint32 returns the uint32 value of the environment variable named by key.
+// It returns an error if the variable is unset, empty, negative, cannot be parsed,
+// or overflows uint32.
+func TryGetEnvUint32(key string) (uint32, error) {
+	u, err := tryGetEnvUintBits(key, 32)
+	return uint32(u), err
+}
+
+// TryGetEnvUint16 returns the uint16 value of the environment variable named by key.
+// It returns an error if the variable is unset, empty, negative, cannot be parsed,
+// or overflows uint16.
+func TryGetEnvUint16(key string) (uint16, error) {
+	u, err := tryGetEnvUintBits(key, 16)
+	return uint16(u), err
+}
+
+// TryGetEnvUint8 returns the uint8 value of the environment variable named by key.
+// It returns an error if the variable is unset, empty, negative, cannot be parsed,
+// or overflows uint8.
+func TryGetEnvUint8(key string) (uint8, error) {
+	u, err := tryGetEnvUintBits(key, 8)
+	return uint8(u), err
+}
+
+// MustGetEnvUint returns the uint value of the environment variable named by key.
+// It panics if the variable is unset, empty, negative, cannot be parsed, or overflows uint.
+func MustGetEnvUint(key string) uint {
+	v, err := TryGetEnvUint(key)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// MustGetEnvUint64 returns the uint64 value of the environment variable named by key.
+// It panics if the variable is unset, empty, negative, cannot be parsed, or overflows uint64.
+func MustGetEnvUint64(key string) uint64 {
+	v, err := TryGetEnvUint64(key)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// MustGetEnvUint32 returns the uint32 value of the environment variable named by key.
+// It panics if the variable is unset, empty, negative, cannot be parsed, or overflows uint32.
+func MustGetEnvUint32(key string) uint32 {
+	v, err := TryGetEnvUint32(key)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// MustGetEnvUint16 returns the uint16 value of the environment variable named by key.
+// It panics if the variable is unset, empty, negative, cannot be parsed, or overflows uint16.
+func MustGetEnvUint16(key string) uint16 {
+	v, err := TryGetEnvUint16(key)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// MustGetEnvUint8 returns the uint8 value of the environment variable named by key.
+// It panics if the variable is unset, empty, negative, cannot be parsed, or overflows uint8.
+func MustGetEnvUint8(key string) uint8 {
+	v, err := TryGetEnvUint8(key)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// tryGetEnvUintBits parses key's value as a base-10 unsigned integer that fits in bits
+// bits, where 0 means the size of uint. A leading minus sign is a syntax error rather
+// than wrapping around.
+func tryGetEnvUintBits(key string, bits int) (uint64, error) {
+	typ := "uint"
+	if bits != 0 {
+		typ = fmt.Sprintf("uint%d", bits)
+	}
+	v, err := tryGetEnv(key, typ)
+	if err != nil {
+		return 0, err
+	}
+	u, err := strconv.ParseUint(v, 10, bits)
+	if err != nil {
+		var numErr *strconv.NumError
+		if errors.As(err, &numErr) {
+			err = numErr.Err
+		}
+		return 0, parseErrorf(key, "unable to convert %q to %s: %w", v, typ, err)
+	}
+	return u, nil
+}
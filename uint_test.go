@@ -0,0 +1,109 @@
+package goenv_test
+
+import (
+	"math"
+	"strconv"
+	"testing"
+
+	"github.com/battlej07/goenv"
+)
+
+/* ---------- uints ---------- */
+
+func TestTryGetEnvUints(t *testing.T) {
+	tryUint := func(k string) (uint64, error) { v, err := goenv.TryGetEnvUint(k); return uint64(v), err }
+	try64 := func(k string) (uint64, error) { return goenv.TryGetEnvUint64(k) }
+	try32 := func(k string) (uint64, error) { v, err := goenv.TryGetEnvUint32(k); return uint64(v), err }
+	try16 := func(k string) (uint64, error) { v, err := goenv.TryGetEnvUint16(k); return uint64(v), err }
+	try8 := func(k string) (uint64, error) { v, err := goenv.TryGetEnvUint8(k); return uint64(v), err }
+
+	tests := []struct {
+		name    string
+		try     func(string) (uint64, error)
+		set     bool
+		value   string
+		want    uint64
+		wantErr string
+	}{
+		{name: "uint max", try: tryUint, set: true, value: strconv.FormatUint(math.MaxUint, 10), want: math.MaxUint},
+		{name: "uint -1", try: tryUint, set: true, value: "-1", wantErr: `unable to convert "-1" to uint: invalid syntax`},
+		{name: "uint64 max", try: try64, set: true, value: "18446744073709551615", want: math.MaxUint64},
+		{name: "uint64 overflow", try: try64, set: true, value: "18446744073709551616", wantErr: `unable to convert "18446744073709551616" to uint64: value out of range`},
+		{name: "uint64 -1", try: try64, set: true, value: "-1", wantErr: `unable to convert "-1" to uint64: invalid syntax`},
+		{name: "uint32 max", try: try32, set: true, value: "4294967295", want: math.MaxUint32},
+		{name: "uint32 overflow", try: try32, set: true, value: "4294967296", wantErr: `unable to convert "4294967296" to uint32: value out of range`},
+		{name: "uint32 -5", try: try32, set: true, value: "-5", wantErr: `unable to convert "-5" to uint32: invalid syntax`},
+		{name: "uint16 max", try: try16, set: true, value: "65535", want: math.MaxUint16},
+		{name: "uint16 -1", try: try16, set: true, value: "-1", wantErr: `unable to convert "-1" to uint16: invalid syntax`},
+		{name: "uint8 max", try: try8, set: true, value: "255", want: math.MaxUint8},
+		{name: "uint8 overflow", try: try8, set: true, value: "256", wantErr: `unable to convert "256" to uint8: value out of range`},
+		{name: "uint8 -1", try: try8, set: true, value: "-1", wantErr: `unable to convert "-1" to uint8: invalid syntax`},
+		{name: "missing", try: try16, set: false, wantErr: "unable to find env variable with key ENV_UINT"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.set {
+				t.Setenv("ENV_UINT", tt.value)
+			}
+			got, err := tt.try("ENV_UINT")
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Fatalf("error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("got %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetEnvUints(t *testing.T) {
+	t.Setenv("ENV_UINT", "-1")
+	if got := goenv.GetEnvUint("ENV_UINT", 1); got != 1 {
+		t.Errorf("GetEnvUint() = %d, want fallback 1", got)
+	}
+	if got := goenv.GetEnvUint32("ENV_UINT", 2); got != 2 {
+		t.Errorf("GetEnvUint32() = %d, want fallback 2", got)
+	}
+
+	t.Setenv("ENV_UINT", "300")
+	if got := goenv.GetEnvUint64("ENV_UINT", 1); got != 300 {
+		t.Errorf("GetEnvUint64() = %d, want 300", got)
+	}
+	if got := goenv.GetEnvUint16("ENV_UINT", 1); got != 300 {
+		t.Errorf("GetEnvUint16() = %d, want 300", got)
+	}
+	if got := goenv.GetEnvUint8("ENV_UINT", 3); got != 3 {
+		t.Errorf("GetEnvUint8() = %d, want fallback 3 on overflow", got)
+	}
+}
+
+func TestMustGetEnvUints(t *testing.T) {
+	tests := []struct {
+		name      string
+		must      func(string) uint64
+		value     string
+		want      uint64
+		wantPanic bool
+	}{
+		{name: "uint", must: func(k string) uint64 { return uint64(goenv.MustGetEnvUint(k)) }, value: "42", want: 42},
+		{name: "uint64", must: func(k string) uint64 { return goenv.MustGetEnvUint64(k) }, value: "42", want: 42},
+		{name: "uint32 negative", must: func(k string) uint64 { return uint64(goenv.MustGetEnvUint32(k)) }, value: "-5", wantPanic: true},
+		{name: "uint16 overflow", must: func(k string) uint64 { return uint64(goenv.MustGetEnvUint16(k)) }, value: "65536", wantPanic: true},
+		{name: "uint8 invalid", must: func(k string) uint64 { return uint64(goenv.MustGetEnvUint8(k)) }, value: "x", wantPanic: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("ENV_UINT", tt.value)
+			defer expectPanic(t, tt.wantPanic)()
+			if got := tt.must("ENV_UINT"); got != tt.want {
+				t.Errorf("got %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
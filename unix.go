@@ -0,0 +1,79 @@
+package goenv
+
+import (
+	"strconv"
+	"time"
+)
+
+// GetEnvUnixTime returns the time of the environment variable named by key, given as
+// an integer number of seconds since the Unix epoch, e.g. "1735689600", in UTC.
+// If the variable is unset, empty, or not an integer, it returns fallback.
+func GetEnvUnixTime(key string, fallback time.Time) time.Time {
+	v, err := TryGetEnvUnixTime(key)
+	return orFallback(key, v, err, fallback)
+}
+
+// GetEnvUnixMilliTime returns the time of the environment variable named by key, given
+// as an integer number of milliseconds since the Unix epoch, in UTC.
+// If the variable is unset, empty, or not an integer, it returns fallback.
+func GetEnvUnixMilliTime(key string, fallback time.Time) time.Time {
+	v, err := TryGetEnvUnixMilliTime(key)
+	return orFallback(key, v, err, fallback)
+}
+
+// TryGetEnvUnixTime returns the time of the environment variable named by key, given as
+// an integer number of seconds since the Unix epoch, in UTC. Negative values are times
+// before 1970. It returns an error if the variable is unset, empty, or not an integer.
+func TryGetEnvUnixTime(key string) (time.Time, error) {
+	n, err := tryGetEnvEpoch(key, "seconds")
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.Unix(n, 0).UTC(), nil
+}
+
+// TryGetEnvUnixMilliTime returns the time of the environment variable named by key,
+// given as an integer number of milliseconds since the Unix epoch, in UTC. It returns an
+// error if the variable is unset, empty, or not an integer.
+func TryGetEnvUnixMilliTime(key string) (time.Time, error) {
+	n, err := tryGetEnvEpoch(key, "milliseconds")
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.UnixMilli(n).UTC(), nil
+}
+
+// MustGetEnvUnixTime returns the time of the environment variable named by key, given
+// as seconds since the Unix epoch. It panics if the variable is unset, empty, or not an
+// integer.
+func MustGetEnvUnixTime(key string) time.Time {
+	v, err := TryGetEnvUnixTime(key)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// MustGetEnvUnixMilliTime returns the time of the environment variable named by key,
+// given as milliseconds since the Unix epoch. It panics if the variable is unset, empty,
+// or not an integer.
+func MustGetEnvUnixMilliTime(key string) time.Time {
+	v, err := TryGetEnvUnixMilliTime(key)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// tryGetEnvEpoch parses key's value as an int64 count of unit since the Unix epoch.
+func tryGetEnvEpoch(key, unit string) (int64, error) {
+	v, err := tryGetEnv(key, "time.Time")
+	if err != nil {
+		return 0, err
+	}
+	n, err := strconv.ParseInt(v, 10, 64)
+	if err != nil {
+		return 0, parseErrorf(key, "unable to parse %q as Unix %s: %w", v, unit, err)
+	}
+	return n, nil
+}
@@ -0,0 +1,106 @@
+package goenv_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/battlej07/goenv"
+)
+
+/* ---------- Unix time ---------- */
+
+func TestTryGetEnvUnixTime(t *testing.T) {
+	tests := []struct {
+		name    string
+		set     bool
+		value   string
+		want    time.Time
+		wantErr bool
+	}{
+		{name: "valid epoch", set: true, value: "1735689600", want: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{name: "zero", set: true, value: "0", want: time.Unix(0, 0).UTC()},
+		{name: "negative epoch", set: true, value: "-86400", want: time.Date(1969, 12, 31, 0, 0, 0, 0, time.UTC)},
+		{name: "non-numeric", set: true, value: "tomorrow", wantErr: true},
+		{name: "fractional", set: true, value: "1.5", wantErr: true},
+		{name: "missing", set: false, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.set {
+				t.Setenv("ENV_UNIX_TIME", tt.value)
+			}
+			got, err := goenv.TryGetEnvUnixTime("ENV_UNIX_TIME")
+			if err != nil {
+				if !tt.wantErr {
+					t.Errorf("TryGetEnvUnixTime() failed: %v", err)
+				}
+				return
+			}
+			if tt.wantErr {
+				t.Fatal("TryGetEnvUnixTime() succeeded unexpectedly")
+			}
+			if !got.Equal(tt.want) || got.Location() != time.UTC {
+				t.Errorf("TryGetEnvUnixTime() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTryGetEnvUnixMilliTime(t *testing.T) {
+	t.Setenv("ENV_UNIX_TIME", "1735689600123")
+	got, err := goenv.TryGetEnvUnixMilliTime("ENV_UNIX_TIME")
+	if want := time.Date(2025, 1, 1, 0, 0, 0, 123e6, time.UTC); err != nil || !got.Equal(want) {
+		t.Errorf("TryGetEnvUnixMilliTime() = %v, %v, want %v", got, err, want)
+	}
+
+	t.Setenv("ENV_UNIX_TIME", "-1")
+	got, err = goenv.TryGetEnvUnixMilliTime("ENV_UNIX_TIME")
+	if want := time.Date(1969, 12, 31, 23, 59, 59, 999e6, time.UTC); err != nil || !got.Equal(want) {
+		t.Errorf("TryGetEnvUnixMilliTime() = %v, %v, want %v", got, err, want)
+	}
+
+	t.Setenv("ENV_UNIX_TIME", "soon")
+	if _, err := goenv.TryGetEnvUnixMilliTime("ENV_UNIX_TIME"); !errors.Is(err, goenv.ErrParse) {
+		t.Errorf("TryGetEnvUnixMilliTime() error = %v, want ErrParse", err)
+	}
+}
+
+func TestGetEnvUnixTime(t *testing.T) {
+	fallback := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+	t.Setenv("ENV_UNIX_TIME", "60")
+	if got := goenv.GetEnvUnixTime("ENV_UNIX_TIME", fallback); !got.Equal(time.Unix(60, 0)) {
+		t.Errorf("GetEnvUnixTime() = %v, want 1970-01-01T00:01:00Z", got)
+	}
+	if got := goenv.GetEnvUnixMilliTime("ENV_UNIX_TIME", fallback); !got.Equal(time.UnixMilli(60)) {
+		t.Errorf("GetEnvUnixMilliTime() = %v, want 60ms after the epoch", got)
+	}
+
+	t.Setenv("ENV_UNIX_TIME", "x")
+	if got := goenv.GetEnvUnixTime("ENV_UNIX_TIME", fallback); !got.Equal(fallback) {
+		t.Errorf("GetEnvUnixTime() = %v, want fallback", got)
+	}
+	if got := goenv.GetEnvUnixMilliTime("ENV_UNIX_TIME", fallback); !got.Equal(fallback) {
+		t.Errorf("GetEnvUnixMilliTime() = %v, want fallback", got)
+	}
+}
+
+func TestMustGetEnvUnixTime(t *testing.T) {
+	t.Setenv("ENV_UNIX_TIME", "x")
+	func() {
+		defer expectPanic(t, true)()
+		goenv.MustGetEnvUnixTime("ENV_UNIX_TIME")
+	}()
+	func() {
+		defer expectPanic(t, true)()
+		goenv.MustGetEnvUnixMilliTime("ENV_UNIX_TIME")
+	}()
+
+	t.Setenv("ENV_UNIX_TIME", "1")
+	func() {
+		defer expectPanic(t, false)()
+		if got := goenv.MustGetEnvUnixTime("ENV_UNIX_TIME"); !got.Equal(time.Unix(1, 0)) {
+			t.Errorf("MustGetEnvUnixTime() = %v", got)
+		}
+	}()
+}
@@ -0,0 +1,43 @@
+package goenv
+
+import "net/url"
+
+// GetEnvURL returns the absolute URL in the environment variable named by key, e.g.
+// "https://api.example.com/v1". If the variable is unset, empty, cannot be parsed, or
+// lacks a scheme or host, it returns fallback.
+func GetEnvURL(key string, fallback *url.URL) *url.URL {
+	v, err := TryGetEnvURL(key)
+	return orFallback(key, v, err, fallback)
+}
+
+// TryGetEnvURL returns the URL in the environment variable named by key, parsed with
+// url.Parse. The URL must have a non-empty scheme and host, so a bare "foo", which
+// url.Parse accepts as a relative path, is rejected. It returns an error naming the
+// failed requirement, or if the variable is unset or empty.
+func TryGetEnvURL(key string) (*url.URL, error) {
+	v, err := tryGetEnv(key, "*url.URL")
+	if err != nil {
+		return nil, err
+	}
+	u, err := url.Parse(v)
+	if err != nil {
+		return nil, parseErrorf(key, "unable to parse %q as URL: %w", v, err)
+	}
+	if u.Scheme == "" {
+		return nil, parseErrorf(key, "URL %q has no scheme", v)
+	}
+	if u.Host == "" {
+		return nil, parseErrorf(key, "URL %q has no host", v)
+	}
+	return u, nil
+}
+
+// MustGetEnvURL returns the URL in the environment variable named by key. It panics if
+// the variable is unset, empty, cannot be parsed, or lacks a scheme or host.
+func MustGetEnvURL(key string) *url.URL {
+	v, err := TryGetEnvURL(key)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
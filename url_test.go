@@ -0,0 +1,64 @@
+package goenv_test
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/battlej07/goenv"
+)
+
+/* ---------- URL ---------- */
+
+func TestTryGetEnvURL(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		want    string
+		wantErr string
+	}{
+		{name: "https", value: "https://api.example.com/v1", want: "https://api.example.com/v1"},
+		{name: "with port and query", value: "http://localhost:8080/x?y=1", want: "http://localhost:8080/x?y=1"},
+		{name: "scheme-less", value: "foo", wantErr: "has no scheme"},
+		{name: "scheme-less host path", value: "api.example.com/v1", wantErr: "has no scheme"},
+		{name: "no host", value: "file:///etc/hosts", wantErr: "has no host"},
+		{name: "invalid", value: "http://[::1", wantErr: "unable to parse"},
+		{name: "control character", value: "https://exa\x7fmple.com", wantErr: "unable to parse"},
+		{name: "empty", value: "", wantErr: "unable to find"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("ENV_URL", tt.value)
+			got, err := goenv.TryGetEnvURL("ENV_URL")
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("TryGetEnvURL() error = %v, want it to contain %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("TryGetEnvURL() failed: %v", err)
+			}
+			if got.String() != tt.want {
+				t.Errorf("TryGetEnvURL() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetEnvURL(t *testing.T) {
+	fallback := &url.URL{Scheme: "http", Host: "localhost"}
+
+	t.Setenv("ENV_URL", "https://api.example.com")
+	if got := goenv.GetEnvURL("ENV_URL", fallback); got.Host != "api.example.com" {
+		t.Errorf("GetEnvURL() = %s, want host api.example.com", got)
+	}
+	t.Setenv("ENV_URL", "foo")
+	if got := goenv.GetEnvURL("ENV_URL", fallback); got != fallback {
+		t.Errorf("GetEnvURL() = %s, want fallback", got)
+	}
+	func() {
+		defer expectPanic(t, true)()
+		goenv.MustGetEnvURL("ENV_URL")
+	}()
+}
@@ -0,0 +1,71 @@
+package goenv
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+)
+
+// GetEnvUUID returns the UUID in the environment variable named by key in its
+// canonical lower-case 8-4-4-4-12 form, e.g. "123e4567-e89b-12d3-a456-426614174000".
+// If strict is false, the 32 hex digits may also be given without hyphens. If the
+// variable is unset, empty, or not a valid UUID, it returns fallback.
+func GetEnvUUID(key string, strict bool, fallback string) string {
+	v, err := TryGetEnvUUID(key, strict)
+	return orFallback(key, v, err, fallback)
+}
+
+// TryGetEnvUUID returns the UUID in the environment variable named by key in its
+// canonical lower-case 8-4-4-4-12 form. Hex digits may be upper- or lower-case. If
+// strict is true, the value must be hyphenated; otherwise 32 bare hex digits are also
+// accepted. It returns an error if the variable is unset, empty, or not a valid UUID.
+func TryGetEnvUUID(key string, strict bool) (string, error) {
+	v, err := TryGetEnv(key)
+	if err != nil {
+		return "", err
+	}
+	u, err := parseUUID(v, strict)
+	if err != nil {
+		return "", parseErrorf(key, "unable to parse %q as UUID: %w", v, err)
+	}
+	return u, nil
+}
+
+// MustGetEnvUUID returns the UUID in the environment variable named by key in its
+// canonical lower-case form. It panics if the variable is unset, empty, or not a
+// valid UUID.
+func MustGetEnvUUID(key string, strict bool) string {
+	v, err := TryGetEnvUUID(key, strict)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// parseUUID validates s as a hyphenated UUID, or unless strict as 32 bare hex
+// digits, and returns it in canonical lower-case form.
+func parseUUID(s string, strict bool) (string, error) {
+	var digits string
+	switch {
+	case len(s) == 36:
+		for _, i := range []int{8, 13, 18, 23} {
+			if s[i] != '-' {
+				return "", fmt.Errorf("expected '-' at position %d", i)
+			}
+		}
+		digits = s[0:8] + s[9:13] + s[14:18] + s[19:23] + s[24:]
+	case len(s) == 32 && !strict:
+		digits = s
+	case strict:
+		return "", fmt.Errorf("expected 36 characters, got %d", len(s))
+	default:
+		return "", fmt.Errorf("expected 32 or 36 characters, got %d", len(s))
+	}
+
+	var b [16]byte
+	if _, err := hex.Decode(b[:], []byte(digits)); err != nil {
+		return "", err
+	}
+	h := hex.EncodeToString(b[:])
+	return strings.Join([]string{h[0:8], h[8:12], h[12:16], h[16:20], h[20:]}, "-"), nil
+}
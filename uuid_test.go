@@ -0,0 +1,71 @@
+package goenv_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/battlej07/goenv"
+)
+
+/* ---------- UUID ---------- */
+
+func TestTryGetEnvUUID(t *testing.T) {
+	const canonical = "123e4567-e89b-12d3-a456-426614174000"
+	tests := []struct {
+		name    string
+		value   string
+		strict  bool
+		want    string
+		wantErr string
+	}{
+		{name: "valid", value: canonical, strict: true, want: canonical},
+		{name: "uppercase", value: strings.ToUpper(canonical), strict: true, want: canonical},
+		{name: "no hyphens lenient", value: "123E4567E89B12D3A456426614174000", want: canonical},
+		{name: "no hyphens strict", value: "123e4567e89b12d3a456426614174000", strict: true, wantErr: "expected 36 characters, got 32"},
+		{name: "invalid length", value: "123e4567-e89b-12d3-a456-42661417400", strict: true, wantErr: "expected 36 characters, got 35"},
+		{name: "invalid length lenient", value: "123e4567", wantErr: "expected 32 or 36 characters, got 8"},
+		{name: "misplaced hyphen", value: "123e456-7e89b-12d3-a456-426614174000", wantErr: "expected '-' at position 8"},
+		{name: "non-hex", value: "123e4567-e89b-12d3-a456-42661417400g", wantErr: "invalid byte"},
+		{name: "empty", value: "", wantErr: "unable to find"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("ENV_UUID", tt.value)
+			got, err := goenv.TryGetEnvUUID("ENV_UUID", tt.strict)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("TryGetEnvUUID() error = %v, want it to contain %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("TryGetEnvUUID() failed: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("TryGetEnvUUID() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetEnvUUID(t *testing.T) {
+	const fallback = "00000000-0000-0000-0000-000000000000"
+	t.Setenv("ENV_UUID", "A0EEBC99-9C0B-4EF8-BB6D-6BB9BD380A11")
+	if got := goenv.GetEnvUUID("ENV_UUID", true, fallback); got != "a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11" {
+		t.Errorf("GetEnvUUID() = %q", got)
+	}
+	t.Setenv("ENV_UUID", "a0eebc999c0b4ef8bb6d6bb9bd380a11")
+	if got := goenv.GetEnvUUID("ENV_UUID", true, fallback); got != fallback {
+		t.Errorf("GetEnvUUID() = %q, want fallback", got)
+	}
+	func() {
+		defer expectPanic(t, true)()
+		goenv.MustGetEnvUUID("ENV_UUID", true)
+	}()
+	func() {
+		defer expectPanic(t, false)()
+		if got := goenv.MustGetEnvUUID("ENV_UUID", false); got != "a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11" {
+			t.Errorf("MustGetEnvUUID() = %q", got)
+		}
+	}()
+}
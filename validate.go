@@ -0,0 +1,133 @@
+package goenv
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+)
+
+// Kind is the type a FieldSpec expects an environment variable to parse as.
+type Kind int
+
+const (
+	KindString   Kind = iota // any value
+	KindInt                  // as parsed by TryGetEnvInt
+	KindFloat                // as parsed by TryGetEnvFloat64
+	KindBool                 // as parsed by TryGetEnvBool
+	KindDuration             // as parsed by TryGetEnvDuration
+	KindTime                 // RFC3339, as parsed by TryGetEnvTime
+)
+
+// String returns the lower-case name of the kind, e.g. "int".
+func (k Kind) String() string {
+	switch k {
+	case KindString:
+		return "string"
+	case KindInt:
+		return "int"
+	case KindFloat:
+		return "float"
+	case KindBool:
+		return "bool"
+	case KindDuration:
+		return "duration"
+	case KindTime:
+		return "time"
+	default:
+		return "Kind(" + strconv.Itoa(int(k)) + ")"
+	}
+}
+
+// FieldSpec describes the expected shape of one environment variable for ValidateEnv.
+// Min and Max, when non-nil, bound the parsed value of KindInt and KindFloat variables
+// and are ignored for other kinds. Enum, when non-empty, lists the allowed raw values.
+type FieldSpec struct {
+	Key      string
+	Type     Kind
+	Required bool
+	Min, Max *float64
+	Enum     []string
+}
+
+// ValidateEnv checks every environment variable described by specs and returns all
+// violations joined with errors.Join, or nil if there are none. An unset or empty
+// variable is a violation only if it is Required; otherwise its other checks are
+// skipped. A value that does not parse as its Type is not range-checked.
+func ValidateEnv(specs []FieldSpec) error {
+	var errs []error
+	for _, spec := range specs {
+		errs = append(errs, validateField(spec)...)
+	}
+	return errors.Join(errs...)
+}
+
+// Require checks that every environment variable named by keys is set and non-empty.
+// It returns a single error naming all missing keys in order, e.g.
+// "missing required env variables: DB_URL, API_KEY", or nil if all are present.
+// The error matches ErrNotFound.
+func Require(keys ...string) error {
+	var missing []string
+	for _, key := range keys {
+		if _, err := TryGetEnv(key); err != nil {
+			missing = append(missing, key)
+		}
+	}
+	if len(missing) > 0 {
+		return missingRequiredError(missing)
+	}
+	return nil
+}
+
+// MustRequire checks that every environment variable named by keys is set and
+// non-empty. It panics with an error naming all missing keys otherwise.
+func MustRequire(keys ...string) {
+	if err := Require(keys...); err != nil {
+		panic(err)
+	}
+}
+
+func validateField(spec FieldSpec) []error {
+	v, err := TryGetEnv(spec.Key)
+	if err != nil {
+		if spec.Required {
+			return []error{fmt.Errorf("%s: required but not set", spec.Key)}
+		}
+		return nil
+	}
+
+	var errs []error
+	var n float64
+	switch spec.Type {
+	case KindString:
+	case KindInt:
+		var i int
+		i, err = TryGetEnvInt(spec.Key)
+		n = float64(i)
+	case KindFloat:
+		n, err = TryGetEnvFloat64(spec.Key)
+	case KindBool:
+		_, err = TryGetEnvBool(spec.Key)
+	case KindDuration:
+		_, err = TryGetEnvDuration(spec.Key)
+	case KindTime:
+		_, err = TryGetEnvTime(spec.Key)
+	default:
+		err = fmt.Errorf("unknown kind %s", spec.Type)
+	}
+	if err != nil {
+		errs = append(errs, fmt.Errorf("%s: not a valid %s: %w", spec.Key, spec.Type, err))
+	} else if spec.Type == KindInt || spec.Type == KindFloat {
+		if spec.Min != nil && n < *spec.Min {
+			errs = append(errs, fmt.Errorf("%s: %v is below minimum %v", spec.Key, n, *spec.Min))
+		}
+		if spec.Max != nil && n > *spec.Max {
+			errs = append(errs, fmt.Errorf("%s: %v is above maximum %v", spec.Key, n, *spec.Max))
+		}
+	}
+
+	if len(spec.Enum) > 0 && !slices.Contains(spec.Enum, v) {
+		errs = append(errs, fmt.Errorf("%s: %q is not one of %q", spec.Key, v, spec.Enum))
+	}
+	return errs
+}
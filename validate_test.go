@@ -0,0 +1,122 @@
+package goenv_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/battlej07/goenv"
+)
+
+/* ---------- ValidateEnv ---------- */
+
+func ptr(f float64) *float64 { return &f }
+
+func TestValidateEnv(t *testing.T) {
+	t.Setenv("VAL_PORT", "70000")
+	t.Setenv("VAL_RATIO", "0.5")
+	t.Setenv("VAL_DEBUG", "maybe")
+	t.Setenv("VAL_MODE", "fast")
+	t.Setenv("VAL_LEVEL", "3")
+	t.Setenv("VAL_TIMEOUT", "5s")
+
+	specs := []goenv.FieldSpec{
+		{Key: "VAL_PORT", Type: goenv.KindInt, Min: ptr(1), Max: ptr(65535)},
+		{Key: "VAL_RATIO", Type: goenv.KindFloat, Min: ptr(0), Max: ptr(1)},
+		{Key: "VAL_DEBUG", Type: goenv.KindBool},
+		{Key: "VAL_MODE", Enum: []string{"slow", "safe"}},
+		{Key: "VAL_LEVEL", Type: goenv.KindInt, Min: ptr(5), Enum: []string{"1", "2"}},
+		{Key: "VAL_TIMEOUT", Type: goenv.KindDuration, Required: true},
+		{Key: "VAL_SECRET", Required: true},
+		{Key: "VAL_OPTIONAL", Type: goenv.KindInt, Min: ptr(1)},
+	}
+
+	err := goenv.ValidateEnv(specs)
+	if err == nil {
+		t.Fatal("ValidateEnv() succeeded unexpectedly")
+	}
+	msg := err.Error()
+	for _, want := range []string{
+		"VAL_PORT: 70000 is above maximum 65535",
+		"VAL_DEBUG: not a valid bool",
+		`VAL_MODE: "fast" is not one of ["slow" "safe"]`,
+		"VAL_LEVEL: 3 is below minimum 5",
+		`VAL_LEVEL: "3" is not one of ["1" "2"]`,
+		"VAL_SECRET: required but not set",
+	} {
+		if !strings.Contains(msg, want) {
+			t.Errorf("ValidateEnv() error missing %q:\n%s", want, msg)
+		}
+	}
+	for _, unwanted := range []string{"VAL_RATIO", "VAL_TIMEOUT", "VAL_OPTIONAL"} {
+		if strings.Contains(msg, unwanted) {
+			t.Errorf("ValidateEnv() error unexpectedly mentions %s:\n%s", unwanted, msg)
+		}
+	}
+	if got := strings.Count(msg, "\n") + 1; got != 6 {
+		t.Errorf("ValidateEnv() reported %d violations, want 6:\n%s", got, msg)
+	}
+}
+
+func TestValidateEnvValid(t *testing.T) {
+	t.Setenv("VAL_PORT", "8080")
+	t.Setenv("VAL_START", "2024-01-02T03:04:05Z")
+
+	specs := []goenv.FieldSpec{
+		{Key: "VAL_PORT", Type: goenv.KindInt, Required: true, Min: ptr(1), Max: ptr(65535)},
+		{Key: "VAL_START", Type: goenv.KindTime},
+		{Key: "VAL_OPTIONAL", Enum: []string{"a"}},
+	}
+	if err := goenv.ValidateEnv(specs); err != nil {
+		t.Errorf("ValidateEnv() = %v, want nil", err)
+	}
+}
+
+/* ---------- Require ---------- */
+
+func TestRequire(t *testing.T) {
+	tests := []struct {
+		name    string
+		env     map[string]string
+		wantErr string
+	}{
+		{name: "all present", env: map[string]string{"REQ_DB_URL": "postgres://", "REQ_API_KEY": "k", "REQ_REGION": "us"}},
+		{name: "some missing", env: map[string]string{"REQ_REGION": "us", "REQ_API_KEY": ""}, wantErr: "missing required env variables: REQ_DB_URL, REQ_API_KEY"},
+		{name: "all missing", env: map[string]string{}, wantErr: "missing required env variables: REQ_DB_URL, REQ_API_KEY, REQ_REGION"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			unsetForTest(t, "REQ_DB_URL", "REQ_API_KEY", "REQ_REGION")
+			for k, v := range tt.env {
+				t.Setenv(k, v)
+			}
+			err := goenv.Require("REQ_DB_URL", "REQ_API_KEY", "REQ_REGION")
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("Require() = %v, want nil", err)
+				}
+				return
+			}
+			if err == nil || err.Error() != tt.wantErr {
+				t.Fatalf("Require() = %v, want %q", err, tt.wantErr)
+			}
+			if !errors.Is(err, goenv.ErrNotFound) {
+				t.Errorf("Require() error does not match ErrNotFound")
+			}
+		})
+	}
+}
+
+func TestMustRequire(t *testing.T) {
+	unsetForTest(t, "REQ_DB_URL")
+	t.Setenv("REQ_API_KEY", "k")
+	func() {
+		defer expectPanic(t, false)()
+		goenv.MustRequire("REQ_API_KEY")
+		goenv.MustRequire()
+	}()
+	func() {
+		defer expectPanic(t, true)()
+		goenv.MustRequire("REQ_API_KEY", "REQ_DB_URL")
+	}()
+}